@@ -1,13 +1,109 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
 	"github.com/spf13/cobra"
 )
 
 var commentCmd = &cobra.Command{
 	Use:   "comment",
-	Short: "View task comments",
-	Long:  `Retrieve comments for ClickUp tasks.`,
+	Short: "Manage task comments",
+	Long: `Read, add, edit, resolve and delete comments on ClickUp tasks, and read
+and post to the comment streams of lists and chat views.`,
+}
+
+// commentTarget works out whose comments a command addresses: a list
+// (--list), a chat view (--view), or otherwise the task given as the first
+// argument. It returns the comments endpoint, its query parameters, a
+// description for messages, and the arguments left after the task ID.
+func commentTarget(cmd *cobra.Command, args []string) (string, map[string]string, string, []string, error) {
+	listID, _ := cmd.Flags().GetString("list")
+	viewID, _ := cmd.Flags().GetString("view")
+
+	switch {
+	case listID != "" && viewID != "":
+		return "", nil, "", nil, fmt.Errorf("only one of --list or --view may be given")
+	case listID != "":
+		return fmt.Sprintf("/list/%s/comment", listID), nil, "list " + listID, args, nil
+	case viewID != "":
+		return fmt.Sprintf("/view/%s/comment", viewID), nil, "view " + viewID, args, nil
+	case len(args) == 0:
+		return "", nil, "", nil, fmt.Errorf("a task ID, --list or --view is required")
+	}
+
+	custom, _ := cmd.Flags().GetBool("custom")
+	params := map[string]string{}
+	if custom {
+		params["custom_task_ids"] = "true"
+		params["team_id"] = client.TeamID()
+	}
+	return fmt.Sprintf("/task/%s/comment", args[0]), params, "task " + args[0], args[1:], nil
+}
+
+// commentContent builds the content fields of a comment request. Markdown
+// is converted to ClickUp's rich-text ops unless plain is set.
+func commentContent(text string, plain bool) map[string]interface{} {
+	if plain {
+		return map[string]interface{}{"comment_text": text}
+	}
+	return map[string]interface{}{"comment": api.MarkdownToOps(text)}
+}
+
+// findComment looks up a comment, or a reply to one, among the comments
+// at endpoint. The API has no way to get a single comment, so this pages
+// back through the stream until the comment turns up.
+func findComment(endpoint string, params map[string]string, commentID string) (*api.Comment, error) {
+	p := map[string]string{}
+	for k, v := range params {
+		p[k] = v
+	}
+	for {
+		var resp api.CommentsResponse
+		if err := client.Get(endpoint, p, &resp); err != nil {
+			return nil, err
+		}
+		for i := range resp.Comments {
+			if resp.Comments[i].ID == commentID {
+				return &resp.Comments[i], nil
+			}
+		}
+		if err := fetchReplies(resp.Comments); err != nil {
+			return nil, err
+		}
+		for _, c := range resp.Comments {
+			for i := range c.Replies {
+				if c.Replies[i].ID == commentID {
+					return &c.Replies[i], nil
+				}
+			}
+		}
+
+		if len(resp.Comments) == 0 {
+			return nil, fmt.Errorf("comment %s not found", commentID)
+		}
+		oldest := resp.Comments[len(resp.Comments)-1]
+		if p["start_id"] == oldest.ID {
+			return nil, fmt.Errorf("comment %s not found", commentID)
+		}
+		p["start"] = oldest.Date
+		p["start_id"] = oldest.ID
+	}
+}
+
+// updateComment applies data (comment_text, assignee, resolved) to a comment.
+func updateComment(commentID string, data map[string]interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("encoding request: %w", err)
+	}
+	if err := client.Put(fmt.Sprintf("/comment/%s", commentID), bytes.NewReader(body), nil, nil); err != nil {
+		return fmt.Errorf("updating comment: %w", err)
+	}
+	return nil
 }
 
 func init() {
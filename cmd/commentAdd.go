@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var commentAddCmd = &cobra.Command{
+	Use:   "add [task-id] [text]",
+	Short: "Add a comment to a task, list or chat view",
+	Long: `Add a comment to a task. The text is taken from the arguments, from stdin
+when it is piped, or else written in $EDITOR.
+
+Use --list or --view instead of a task ID to post to a list's comments or
+a chat view; all arguments are then taken as the text:
+
+  clickup-cli comment add --list 901 "Standup summary: ..."
+
+The text is read as Markdown and posted as rich text; use --plain to post
+it verbatim.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		assignee, _ := cmd.Flags().GetString("assignee")
+		notifyAll, _ := cmd.Flags().GetBool("notify-all")
+		plain, _ := cmd.Flags().GetBool("plain")
+
+		endpoint, params, target, rest, err := commentTarget(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		text, err := readText(rest, "")
+		if err != nil {
+			return err
+		}
+
+		data := commentContent(text, plain)
+		data["notify_all"] = notifyAll
+		if assignee != "" {
+			ids, err := resolveUserIDs([]string{assignee})
+			if err != nil {
+				return err
+			}
+			data["assignee"] = ids[0]
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		var created api.CreatedComment
+		if err := client.Post(endpoint, bytes.NewReader(body), params, &created); err != nil {
+			return fmt.Errorf("adding comment: %w", err)
+		}
+
+		fmt.Printf("Comment added to %s: %s\n", target, created.ID)
+		return nil
+	},
+}
+
+func init() {
+	commentCmd.AddCommand(commentAddCmd)
+	commentAddCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+	commentAddCmd.Flags().StringP("list", "l", "", "Post to this list ID instead of a task")
+	commentAddCmd.Flags().StringP("view", "v", "", "Post to this chat view ID instead of a task")
+	commentAddCmd.Flags().StringP("assignee", "a", "", "Assign the comment to a user (ID, username or email)")
+	commentAddCmd.Flags().BoolP("notify-all", "n", false, "Notify everyone watching the task, including the author")
+	commentAddCmd.Flags().Bool("plain", false, "Post the text as-is instead of converting Markdown to rich text")
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var commentDeleteCmd = &cobra.Command{
+	Use:   "delete <comment-id>",
+	Short: "Delete a comment",
+	Long:  `Delete a comment by its ID (shown by "comment get").`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID := args[0]
+
+		if err := client.Delete(fmt.Sprintf("/comment/%s", commentID), nil, nil); err != nil {
+			return fmt.Errorf("deleting comment: %w", err)
+		}
+
+		fmt.Printf("Comment deleted: %s\n", commentID)
+		return nil
+	},
+}
+
+func init() {
+	commentCmd.AddCommand(commentDeleteCmd)
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var commentEditCmd = &cobra.Command{
+	Use:   "edit <comment-id> [text]",
+	Short: "Replace the text of a comment",
+	Long: `Replace the text of a comment. The text is taken from the arguments, from
+stdin when it is piped, or else written in $EDITOR starting from the
+comment's current text. As the API cannot look up a single comment, the
+editor needs to know where the comment is: give its --task, --list or
+--view.
+
+The text is read as Markdown and posted as rich text; use --plain to post
+it verbatim.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID := args[0]
+		assignee, _ := cmd.Flags().GetString("assignee")
+		plain, _ := cmd.Flags().GetBool("plain")
+
+		initial := ""
+		if len(args) == 1 && !stdinPiped() {
+			taskID, _ := cmd.Flags().GetString("task")
+			listID, _ := cmd.Flags().GetString("list")
+			viewID, _ := cmd.Flags().GetString("view")
+			if taskID == "" && listID == "" && viewID == "" {
+				return fmt.Errorf("give the comment's --task, --list or --view to edit its current text, or pass the new text")
+			}
+			var target []string
+			if taskID != "" {
+				target = []string{taskID}
+			}
+			endpoint, params, _, _, err := commentTarget(cmd, target)
+			if err != nil {
+				return err
+			}
+			c, err := findComment(endpoint, params, commentID)
+			if err != nil {
+				return fmt.Errorf("getting comment: %w", err)
+			}
+			initial = c.CommentText
+			if !plain && len(c.Comment) > 0 {
+				initial = api.OpsToMarkdown(c.Comment)
+			}
+		}
+
+		text, err := readText(args[1:], initial)
+		if err != nil {
+			return err
+		}
+
+		data := commentContent(text, plain)
+		if assignee != "" {
+			ids, err := resolveUserIDs([]string{assignee})
+			if err != nil {
+				return err
+			}
+			data["assignee"] = ids[0]
+		}
+
+		if err := updateComment(commentID, data); err != nil {
+			return err
+		}
+
+		fmt.Printf("Comment updated: %s\n", commentID)
+		return nil
+	},
+}
+
+func init() {
+	commentCmd.AddCommand(commentEditCmd)
+	commentEditCmd.Flags().StringP("task", "t", "", "Task the comment is on, to edit its current text")
+	commentEditCmd.Flags().BoolP("custom", "c", false, "Treat --task as a custom task ID")
+	commentEditCmd.Flags().StringP("list", "l", "", "List the comment is on, to edit its current text")
+	commentEditCmd.Flags().StringP("view", "v", "", "Chat view the comment is in, to edit its current text")
+	commentEditCmd.Flags().StringP("assignee", "a", "", "Reassign the comment to a user (ID, username or email)")
+	commentEditCmd.Flags().Bool("plain", false, "Post the text as-is instead of converting Markdown to rich text")
+}
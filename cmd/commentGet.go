@@ -2,34 +2,79 @@ package cmd
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/otard95/clickup-cli/internal/api"
 	"github.com/spf13/cobra"
 )
 
+// fetchReplies fills in the Replies of every comment that has any,
+// fetching the threads in parallel.
+func fetchReplies(comments []api.Comment) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(comments))
+	sem := make(chan struct{}, fetchConcurrency)
+
+	for i := range comments {
+		if comments[i].ReplyCount == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(c *api.Comment, err *error) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var resp api.CommentsResponse
+			if e := client.Get(fmt.Sprintf("/comment/%s/reply", c.ID), nil, &resp); e != nil {
+				*err = fmt.Errorf("getting replies to %s: %w", c.ID, e)
+				return
+			}
+			c.Replies = resp.Comments
+		}(&comments[i], &errs[i])
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 var commentGetCmd = &cobra.Command{
-	Use:   "get <task-id>",
-	Short: "Get all comments for a task",
-	Long:  `Retrieve all comments for a specific task showing author, date, and content.`,
-	Args:  cobra.ExactArgs(1),
+	Use:   "get [task-id]",
+	Short: "Get all comments for a task, list or chat view",
+	Long: `Retrieve all comments for a specific task showing author, date, and content.
+Threaded replies are shown indented beneath the comment they answer.
+
+Use --list or --view instead of a task ID to read the comments on a list
+or the messages in a chat view.`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		taskID := args[0]
+		endpoint, params, target, _, err := commentTarget(cmd, args)
+		if err != nil {
+			return err
+		}
 
 		var resp api.CommentsResponse
-		if err := client.Get(fmt.Sprintf("/task/%s/comment", taskID), nil, &resp); err != nil {
+		if err := client.Get(endpoint, params, &resp); err != nil {
 			return fmt.Errorf("getting comments: %w", err)
 		}
 
 		if len(resp.Comments) == 0 {
-			fmt.Println("No comments found for this task.")
+			fmt.Printf("No comments found for %s.\n", target)
 			return nil
 		}
 
+		if err := fetchReplies(resp.Comments); err != nil {
+			return err
+		}
+
 		fmt.Printf("Found %d comment(s):\n\n", len(resp.Comments))
 		for _, c := range resp.Comments {
-			fmt.Printf("--- %s  (%s) ---\n", c.User.Username, api.FormatTimestamp(c.Date))
-			fmt.Printf("%s\n", c.CommentText)
-			fmt.Printf("ID: %s\n\n", c.ID)
+			fmt.Println(api.FormatComment(c))
 		}
 
 		return nil
@@ -38,4 +83,7 @@ var commentGetCmd = &cobra.Command{
 
 func init() {
 	commentCmd.AddCommand(commentGetCmd)
+	commentGetCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+	commentGetCmd.Flags().StringP("list", "l", "", "Get the comments on this list ID instead of a task")
+	commentGetCmd.Flags().StringP("view", "v", "", "Get the messages in this chat view ID instead of a task")
 }
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var commentReplyCmd = &cobra.Command{
+	Use:   "reply <comment-id> [text]",
+	Short: "Reply to a comment",
+	Long: `Add a threaded reply to a comment. The text is taken from the arguments,
+from stdin when it is piped, or else written in $EDITOR.
+
+The text is read as Markdown and posted as rich text; use --plain to post
+it verbatim.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID := args[0]
+		assignee, _ := cmd.Flags().GetString("assignee")
+		notifyAll, _ := cmd.Flags().GetBool("notify-all")
+		plain, _ := cmd.Flags().GetBool("plain")
+
+		text, err := readText(args[1:], "")
+		if err != nil {
+			return err
+		}
+
+		data := commentContent(text, plain)
+		data["notify_all"] = notifyAll
+		if assignee != "" {
+			ids, err := resolveUserIDs([]string{assignee})
+			if err != nil {
+				return err
+			}
+			data["assignee"] = ids[0]
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		var created api.CreatedComment
+		if err := client.Post(fmt.Sprintf("/comment/%s/reply", commentID), bytes.NewReader(body), nil, &created); err != nil {
+			return fmt.Errorf("replying to comment: %w", err)
+		}
+
+		fmt.Printf("Reply added: %s\n", created.ID)
+		return nil
+	},
+}
+
+func init() {
+	commentCmd.AddCommand(commentReplyCmd)
+	commentReplyCmd.Flags().StringP("assignee", "a", "", "Assign the reply to a user (ID, username or email)")
+	commentReplyCmd.Flags().BoolP("notify-all", "n", false, "Notify everyone watching the task, including the author")
+	commentReplyCmd.Flags().Bool("plain", false, "Post the text as-is instead of converting Markdown to rich text")
+}
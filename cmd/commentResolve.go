@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var commentResolveCmd = &cobra.Command{
+	Use:   "resolve <comment-id>",
+	Short: "Mark a comment as resolved",
+	Long:  `Mark a comment as resolved.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID := args[0]
+
+		if err := updateComment(commentID, map[string]interface{}{"resolved": true}); err != nil {
+			return err
+		}
+
+		fmt.Printf("Comment resolved: %s\n", commentID)
+		return nil
+	},
+}
+
+func init() {
+	commentCmd.AddCommand(commentResolveCmd)
+}
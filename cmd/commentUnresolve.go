@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var commentUnresolveCmd = &cobra.Command{
+	Use:   "unresolve <comment-id>",
+	Short: "Reopen a resolved comment",
+	Long:  `Reopen a resolved comment.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID := args[0]
+
+		if err := updateComment(commentID, map[string]interface{}{"resolved": false}); err != nil {
+			return err
+		}
+
+		fmt.Printf("Comment unresolved: %s\n", commentID)
+		return nil
+	},
+}
+
+func init() {
+	commentCmd.AddCommand(commentUnresolveCmd)
+}
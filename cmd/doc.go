@@ -1,13 +1,81 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
 	"github.com/spf13/cobra"
 )
 
 var docCmd = &cobra.Command{
 	Use:   "doc",
 	Short: "Manage ClickUp documents",
-	Long:  `Read and search ClickUp documents.`,
+	Long:  `Read, search, create and edit ClickUp documents and their pages.`,
+}
+
+// docsEndpoint builds a v3 docs path in the configured workspace, e.g.
+// docsEndpoint(docID, "pages") for /workspaces/{id}/docs/{doc}/pages.
+func docsEndpoint(parts ...string) string {
+	return fmt.Sprintf("/workspaces/%s/docs", client.TeamID()) + strings.Join(append([]string{""}, parts...), "/")
+}
+
+// fetchDoc returns a document's metadata.
+func fetchDoc(docID string) (*api.Document, error) {
+	var doc api.Document
+	if err := client.V3().Get(docsEndpoint(docID), nil, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// listDocs returns every document in the workspace, following the
+// pagination cursor. Deleted and archived documents are left out.
+func listDocs() ([]api.Document, error) {
+	var docs []api.Document
+	params := map[string]string{}
+	for {
+		var resp api.DocsResponse
+		if err := client.V3().Get(docsEndpoint(), params, &resp); err != nil {
+			return nil, err
+		}
+		for _, d := range resp.Docs {
+			if !d.Deleted && !d.Archived {
+				docs = append(docs, d)
+			}
+		}
+		if resp.NextCursor == "" || len(resp.Docs) == 0 {
+			return docs, nil
+		}
+		params["next_cursor"] = resp.NextCursor
+	}
+}
+
+// fetchDocPages returns a document's full page tree. With content set, the
+// pages include their Markdown content.
+func fetchDocPages(docID string, content bool) ([]api.DocPage, error) {
+	endpoint := docsEndpoint(docID, "page_listing")
+	params := map[string]string{"max_page_depth": "-1"}
+	if content {
+		endpoint = docsEndpoint(docID, "pages")
+		params["content_format"] = "text/md"
+	}
+
+	var pages []api.DocPage
+	if err := client.V3().Get(endpoint, params, &pages); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// fetchDocPage returns one page of a document with its Markdown content.
+func fetchDocPage(docID, pageID string) (*api.DocPage, error) {
+	var page api.DocPage
+	params := map[string]string{"content_format": "text/md"}
+	if err := client.V3().Get(docsEndpoint(docID, "pages", pageID), params, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
 }
 
 func init() {
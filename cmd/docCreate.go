@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// docParentTypes maps parent kinds to the v3 API's numeric location types.
+var docParentTypes = map[string]int{
+	"space":  4,
+	"folder": 5,
+	"list":   6,
+}
+
+var docCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a document",
+	Long: `Create a document in the workspace, or inside a space, folder or list
+given as --parent kind:id, e.g. --parent space:90123 or --parent list:901.
+Add pages with "doc page create".`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := strings.Join(args, " ")
+		parent, _ := cmd.Flags().GetString("parent")
+
+		data := map[string]interface{}{
+			"name":        name,
+			"create_page": false,
+		}
+		if parent != "" {
+			kind, id, ok := strings.Cut(parent, ":")
+			parentType, known := docParentTypes[strings.ToLower(kind)]
+			if !ok || !known || id == "" {
+				return fmt.Errorf("invalid --parent %q (expected space:<id>, folder:<id> or list:<id>)", parent)
+			}
+			data["parent"] = map[string]interface{}{"id": id, "type": parentType}
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		var doc api.Document
+		if err := client.V3().Post(docsEndpoint(), bytes.NewReader(body), nil, &doc); err != nil {
+			return fmt.Errorf("creating document: %w", err)
+		}
+
+		fmt.Printf("Document created: %s (ID: %s)\n", doc.Name, doc.ID)
+		return nil
+	},
+}
+
+func init() {
+	docCmd.AddCommand(docCreateCmd)
+	docCreateCmd.Flags().StringP("parent", "p", "", "Where to create the document: space:<id>, folder:<id> or list:<id>")
+}
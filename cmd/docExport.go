@@ -0,0 +1,336 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// exportManifestName is the file in an export directory recording which
+// file each page was written to, so renamed and deleted pages can be
+// cleaned up on the next export.
+const exportManifestName = ".clickup-export.json"
+
+type exportManifest struct {
+	Files map[string]string `json:"files"`
+}
+
+// exportPage is a page placed in an export tree.
+type exportPage struct {
+	doc  api.Document
+	page api.DocPage
+	path string // slash-separated, relative to the export directory
+}
+
+var slugInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify turns a page or doc name into a file name, falling back to id.
+func slugify(name, id string) string {
+	s := strings.Trim(slugInvalid.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if s == "" {
+		return id
+	}
+	return s
+}
+
+// planExport assigns every page of doc a Markdown file under root. A page
+// is written to <slug>.md and its subpages to files in a <slug>/ directory
+// next to it, so paths stay stable as pages gain children.
+func planExport(doc api.Document, pages []api.DocPage, root string) []exportPage {
+	var out []exportPage
+	var walk func(pages []api.DocPage, dir string)
+	walk = func(pages []api.DocPage, dir string) {
+		used := map[string]int{}
+		for _, p := range pages {
+			if p.Archived {
+				continue
+			}
+			slug := slugify(p.Name, p.ID)
+			if used[slug]++; used[slug] > 1 {
+				slug = fmt.Sprintf("%s-%d", slug, used[slug])
+			}
+			out = append(out, exportPage{doc: doc, page: p, path: path.Join(dir, slug+".md")})
+			walk(p.Pages, path.Join(dir, slug))
+		}
+	}
+	walk(pages, root)
+	return out
+}
+
+// docLinkPattern matches app links to a doc or one of its pages.
+var docLinkPattern = regexp.MustCompile(`https://app\.clickup\.com/\d+/v/dc/([A-Za-z0-9-]+)(?:/([A-Za-z0-9-]+))?(?:\?[^\s)\]>"]*)?`)
+
+// linkTargets maps doc and page IDs to the exported file they link to. A
+// link to a whole doc points at its first page.
+func linkTargets(pages []exportPage) map[string]string {
+	targets := map[string]string{}
+	for _, p := range pages {
+		targets[p.page.ID] = p.path
+		if _, ok := targets[p.doc.ID]; !ok {
+			targets[p.doc.ID] = p.path
+		}
+	}
+	return targets
+}
+
+// rewriteDocLinks replaces links to exported docs and pages with paths
+// relative to the file at from. Links to anything else are kept.
+func rewriteDocLinks(content, from string, targets map[string]string) string {
+	return docLinkPattern.ReplaceAllStringFunc(content, func(link string) string {
+		m := docLinkPattern.FindStringSubmatch(link)
+		target, ok := targets[m[2]]
+		if !ok {
+			target, ok = targets[m[1]]
+		}
+		if !ok {
+			return link
+		}
+		rel, err := filepath.Rel(path.Dir(from), target)
+		if err != nil {
+			return link
+		}
+		return filepath.ToSlash(rel)
+	})
+}
+
+// localLinkPattern matches a relative path to a Markdown file, as
+// rewriteDocLinks writes in place of an app link.
+var localLinkPattern = regexp.MustCompile(`[^\s()<>\[\]"']+\.md`)
+
+// restoreDocLinks is the reverse of rewriteDocLinks: relative paths from
+// the file at from to the files in pageIDs (path to page ID) become app
+// links to those pages of doc again. Other paths are kept.
+func restoreDocLinks(content, from, docID string, pageIDs map[string]string) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range localLinkPattern.FindAllStringIndex(content, -1) {
+		rel := content[m[0]:m[1]]
+		if next, _ := utf8.DecodeRuneInString(content[m[1]:]); unicode.IsLetter(next) || unicode.IsDigit(next) {
+			continue
+		}
+		if strings.HasPrefix(rel, "/") || strings.Contains(rel, "://") {
+			continue
+		}
+		id, ok := pageIDs[path.Join(path.Dir(from), rel)]
+		if !ok {
+			continue
+		}
+		b.WriteString(content[last:m[0]])
+		fmt.Fprintf(&b, "https://app.clickup.com/%s/v/dc/%s/%s", client.TeamID(), docID, id)
+		last = m[1]
+	}
+	b.WriteString(content[last:])
+	return b.String()
+}
+
+// renderExportPage returns the file content for a page: YAML front matter
+// followed by its Markdown.
+func renderExportPage(p exportPage, creator, content string) []byte {
+	var b bytes.Buffer
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "id: %s\n", strconv.Quote(p.page.ID))
+	fmt.Fprintf(&b, "name: %s\n", strconv.Quote(p.page.Name))
+	fmt.Fprintf(&b, "doc_id: %s\n", strconv.Quote(p.doc.ID))
+	fmt.Fprintf(&b, "doc: %s\n", strconv.Quote(p.doc.Name))
+	fmt.Fprintf(&b, "creator: %s\n", strconv.Quote(creator))
+	fmt.Fprintf(&b, "created: %s\n", strconv.Quote(api.FormatTimestamp(string(p.doc.DateCreated))))
+	fmt.Fprintf(&b, "updated: %s\n", strconv.Quote(api.FormatTimestamp(string(p.page.DateUpdated))))
+	b.WriteString("---\n\n")
+	b.WriteString(strings.TrimSpace(content))
+	b.WriteString("\n")
+	return b.Bytes()
+}
+
+// splitFrontMatter separates leading YAML front matter from a Markdown
+// file. Only simple "key: value" lines are read.
+func splitFrontMatter(data []byte) (map[string]string, string) {
+	meta := map[string]string{}
+	s := string(data)
+	if !strings.HasPrefix(s, "---\n") {
+		return meta, s
+	}
+	end := strings.Index(s[4:], "\n---\n")
+	if end < 0 {
+		return meta, s
+	}
+	for _, line := range strings.Split(s[4:4+end], "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		meta[strings.TrimSpace(key)] = value
+	}
+	return meta, strings.TrimPrefix(s[4+end+5:], "\n")
+}
+
+// insideDir reports whether the slash-separated path p stays inside the
+// directory it is relative to: it must not be absolute or climb out with
+// "..".
+func insideDir(p string) bool {
+	if p == "" || path.IsAbs(p) || filepath.IsAbs(filepath.FromSlash(p)) {
+		return false
+	}
+	for _, part := range strings.Split(filepath.ToSlash(p), "/") {
+		if part == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// writeIfChanged writes data to name unless the file already holds exactly
+// that content. It reports whether the file was written.
+func writeIfChanged(name string, data []byte) (bool, error) {
+	if current, err := os.ReadFile(name); err == nil && bytes.Equal(current, data) {
+		return false, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return false, err
+	}
+	return true, os.WriteFile(name, data, 0o644)
+}
+
+var docExportCmd = &cobra.Command{
+	Use:   "export <doc-id> <dir>",
+	Short: "Export documents to a Markdown directory",
+	Long: `Write each page of a document as a Markdown file with YAML front matter
+(page and doc ID and name, creator and dates). The directory tree mirrors
+the page hierarchy: a page is written to <name>.md and its subpages into a
+<name>/ directory beside it.
+
+With --all, every document in the workspace is exported, each into its
+own subdirectory. Links between exported pages are rewritten to relative
+paths.
+
+Re-running an export only rewrites pages whose content changed and
+removes files of pages that were renamed or deleted.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if all, _ := cmd.Flags().GetBool("all"); all {
+			return cobra.ExactArgs(1)(cmd, args)
+		}
+		return cobra.ExactArgs(2)(cmd, args)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all")
+		dir := args[len(args)-1]
+
+		var docs []api.Document
+		if all {
+			var err error
+			if docs, err = listDocs(); err != nil {
+				return fmt.Errorf("listing documents: %w", err)
+			}
+		} else {
+			doc, err := fetchDoc(args[0])
+			if err != nil {
+				return fmt.Errorf("getting document: %w", err)
+			}
+			docs = []api.Document{*doc}
+		}
+
+		var planned []exportPage
+		content := map[string]string{}
+		usedDirs := map[string]int{}
+		for _, doc := range docs {
+			pages, err := fetchDocPages(doc.ID, true)
+			if err != nil {
+				return fmt.Errorf("reading pages of %s: %w", doc.Name, err)
+			}
+			root := ""
+			if all {
+				root = slugify(doc.Name, doc.ID)
+				if usedDirs[root]++; usedDirs[root] > 1 {
+					root = fmt.Sprintf("%s-%d", root, usedDirs[root])
+				}
+			}
+			api.WalkPages(pages, func(p api.DocPage, _ int) {
+				content[p.ID] = p.Content
+			})
+			planned = append(planned, planExport(doc, pages, root)...)
+		}
+
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("creating export directory: %w", err)
+		}
+		var manifest exportManifest
+		if data, err := os.ReadFile(filepath.Join(dir, exportManifestName)); err == nil {
+			_ = json.Unmarshal(data, &manifest)
+		}
+		for _, old := range manifest.Files {
+			if !insideDir(old) {
+				return fmt.Errorf("%s lists %q, which is outside the export directory", exportManifestName, old)
+			}
+		}
+
+		names := memberNames()
+		targets := linkTargets(planned)
+		next := exportManifest{Files: map[string]string{}}
+		var written, unchanged, removed int
+		for _, p := range planned {
+			body := rewriteDocLinks(content[p.page.ID], p.path, targets)
+			data := renderExportPage(p, userName(names, int64(p.doc.Creator)), body)
+			changed, err := writeIfChanged(filepath.Join(dir, filepath.FromSlash(p.path)), data)
+			if err != nil {
+				return fmt.Errorf("writing %s: %w", p.path, err)
+			}
+			if changed {
+				fmt.Printf("wrote   %s\n", p.path)
+				written++
+			} else {
+				unchanged++
+			}
+			next.Files[p.page.ID] = p.path
+		}
+
+		// Remove files of pages that are gone or moved, but only those this
+		// export wrote before.
+		current := map[string]bool{}
+		for _, p := range next.Files {
+			current[p] = true
+		}
+		for _, old := range manifest.Files {
+			if current[old] {
+				continue
+			}
+			if err := os.Remove(filepath.Join(dir, filepath.FromSlash(old))); err == nil {
+				fmt.Printf("removed %s\n", old)
+				removed++
+				if parent := path.Dir(old); parent != "." {
+					_ = os.Remove(filepath.Join(dir, filepath.FromSlash(parent)))
+				}
+			}
+		}
+
+		data, err := json.MarshalIndent(next, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding manifest: %w", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, exportManifestName), append(data, '\n'), 0o644); err != nil {
+			return fmt.Errorf("writing manifest: %w", err)
+		}
+
+		fmt.Printf("\nExported %d document(s) to %s: %d page(s) written, %d unchanged, %d removed\n",
+			len(docs), dir, written, unchanged, removed)
+		return nil
+	},
+}
+
+func init() {
+	docCmd.AddCommand(docExportCmd)
+	docExportCmd.Flags().Bool("all", false, "Export every document in the workspace")
+}
@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// docCache is a document's pages as cached on disk, keyed by a version
+// that changes whenever the document or one of its pages is edited.
+type docCache struct {
+	Version string        `json:"version"`
+	Pages   []api.DocPage `json:"pages"`
+}
+
+// docVersion identifies the current state of a document from its cheap
+// page listing: the newest update time of the document and its pages, and
+// the page count, so added and removed pages count as changes too.
+func docVersion(doc api.Document) (string, error) {
+	pages, err := fetchDocPages(doc.ID, false)
+	if err != nil {
+		return "", err
+	}
+	newest, _ := strconv.ParseInt(string(doc.DateUpdated), 10, 64)
+	count := 0
+	api.WalkPages(pages, func(p api.DocPage, _ int) {
+		count++
+		if ms, _ := strconv.ParseInt(string(p.DateUpdated), 10, 64); ms > newest {
+			newest = ms
+		}
+	})
+	return fmt.Sprintf("%d/%d", newest, count), nil
+}
+
+// docCachePath returns the cache file for a document, or "" if there is
+// no user cache directory.
+func docCachePath(docID string) string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "clickup-cli", "docs", client.TeamID(), docID+".json")
+}
+
+// cachedDocPages returns a document's pages with content, from the cache
+// if it is still current and otherwise from the API, refreshing the cache.
+func cachedDocPages(doc api.Document, refresh bool) ([]api.DocPage, error) {
+	path := docCachePath(doc.ID)
+	version, err := docVersion(doc)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" && !refresh {
+		if data, err := os.ReadFile(path); err == nil {
+			var c docCache
+			if json.Unmarshal(data, &c) == nil && c.Version == version {
+				return c.Pages, nil
+			}
+		}
+	}
+
+	pages, err := fetchDocPages(doc.ID, true)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		if data, err := json.Marshal(docCache{Version: version, Pages: pages}); err == nil {
+			if os.MkdirAll(filepath.Dir(path), 0o755) == nil {
+				_ = os.WriteFile(path, data, 0o600)
+			}
+		}
+	}
+	return pages, nil
+}
+
+// grepMatches returns the matching lines of content with up to context
+// lines around each, grep style: "n:" marks a match, "n-" context and
+// "--" separates groups.
+func grepMatches(content string, re *regexp.Regexp, context int) ([]string, int) {
+	lines := strings.Split(content, "\n")
+	show := make([]bool, len(lines))
+	matched := make([]bool, len(lines))
+	count := 0
+	for i, l := range lines {
+		if re.MatchString(l) {
+			matched[i] = true
+			count++
+			for j := max(0, i-context); j <= min(len(lines)-1, i+context); j++ {
+				show[j] = true
+			}
+		}
+	}
+
+	var out []string
+	last := -1
+	for i, l := range lines {
+		if !show[i] {
+			continue
+		}
+		if last >= 0 && i > last+1 {
+			out = append(out, "--")
+		}
+		sep := "-"
+		if matched[i] {
+			sep = ":"
+		}
+		out = append(out, fmt.Sprintf("%d%s%s", i+1, sep, l))
+		last = i
+	}
+	return out, count
+}
+
+var docGrepCmd = &cobra.Command{
+	Use:   "grep <pattern>",
+	Short: "Search the content of document pages",
+	Long: `Search the Markdown content of every page in the workspace's documents
+with a regular expression (Go RE2 syntax) and print matching lines with
+their doc and page names, like grep.
+
+Page contents are cached in the user cache directory and refetched when a
+document or any of its pages changes; use --refresh to bypass the cache.
+Limit the search to particular documents with --doc.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ignoreCase, _ := cmd.Flags().GetBool("ignore-case")
+		context, _ := cmd.Flags().GetInt("context")
+		docIDs, _ := cmd.Flags().GetStringSlice("doc")
+		filesOnly, _ := cmd.Flags().GetBool("files-with-matches")
+		refresh, _ := cmd.Flags().GetBool("refresh")
+
+		if context < 0 {
+			return fmt.Errorf("invalid --context %d (must not be negative)", context)
+		}
+
+		pattern := args[0]
+		if ignoreCase {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+
+		var docs []api.Document
+		if len(docIDs) > 0 {
+			for _, id := range docIDs {
+				doc, err := fetchDoc(id)
+				if err != nil {
+					return fmt.Errorf("getting document %s: %w", id, err)
+				}
+				docs = append(docs, *doc)
+			}
+		} else if docs, err = listDocs(); err != nil {
+			return fmt.Errorf("listing documents: %w", err)
+		}
+
+		pages := make([][]api.DocPage, len(docs))
+		errs := make([]error, len(docs))
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, fetchConcurrency)
+		for i, doc := range docs {
+			wg.Add(1)
+			go func(i int, doc api.Document) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				pages[i], errs[i] = cachedDocPages(doc, refresh)
+			}(i, doc)
+		}
+		wg.Wait()
+
+		total, pagesMatched := 0, 0
+		for i, doc := range docs {
+			if errs[i] != nil {
+				fmt.Fprintf(os.Stderr, "Skipping %s: %v\n", doc.Name, errs[i])
+				continue
+			}
+			api.WalkPages(pages[i], func(p api.DocPage, _ int) {
+				lines, count := grepMatches(p.Content, re, context)
+				if count == 0 {
+					return
+				}
+				total += count
+				pagesMatched++
+
+				fmt.Printf("%s › %s (%s/%s)\n", doc.Name, api.Or(p.Name, "Untitled"), doc.ID, p.ID)
+				if filesOnly {
+					return
+				}
+				for _, l := range lines {
+					fmt.Printf("  %s\n", l)
+				}
+				fmt.Println()
+			})
+		}
+
+		if total == 0 {
+			fmt.Println("No matches found.")
+			return nil
+		}
+		if filesOnly {
+			fmt.Println()
+		}
+		fmt.Printf("%d match(es) in %d page(s)\n", total, pagesMatched)
+		return nil
+	},
+}
+
+func init() {
+	docCmd.AddCommand(docGrepCmd)
+	docGrepCmd.Flags().BoolP("ignore-case", "i", false, "Match case-insensitively")
+	docGrepCmd.Flags().IntP("context", "C", 0, "Lines of context around each match")
+	docGrepCmd.Flags().StringSliceP("doc", "d", nil, "Only search these document IDs")
+	docGrepCmd.Flags().BoolP("files-with-matches", "l", false, "Only list the pages that match")
+	docGrepCmd.Flags().Bool("refresh", false, "Refetch page contents instead of using the cache")
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var docPageCmd = &cobra.Command{
+	Use:   "page",
+	Short: "Create and edit document pages",
+	Long:  `Create pages in a document and edit their content.`,
+}
+
+// pageContent returns page Markdown from --file ("-" for stdin), else
+// whatever the user writes in $EDITOR, pre-filled with initial. Stdin is
+// only read when asked for, so an empty pipe in a script cannot stand in
+// for the content.
+func pageContent(cmd *cobra.Command, initial string) (string, error) {
+	file, _ := cmd.Flags().GetString("file")
+	switch file {
+	case "":
+		text, err := editText(initial)
+		if err != nil {
+			return "", err
+		}
+		if text == "" {
+			return "", fmt.Errorf("aborted: empty text")
+		}
+		return text, nil
+	case "-":
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("reading stdin: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("reading page file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func init() {
+	docCmd.AddCommand(docPageCmd)
+}
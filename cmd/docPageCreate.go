@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var docPageCreateCmd = &cobra.Command{
+	Use:   "create <doc-id>",
+	Short: "Add a page to a document",
+	Long: `Add a page to a document. The Markdown content is read from --file
+(--file - for stdin), or else written in $EDITOR. The page name defaults
+to the file name without its extension.
+
+Use --parent-page to nest the page under another one.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		docID := args[0]
+		name, _ := cmd.Flags().GetString("name")
+		subTitle, _ := cmd.Flags().GetString("sub-title")
+		parentPage, _ := cmd.Flags().GetString("parent-page")
+		file, _ := cmd.Flags().GetString("file")
+
+		if name == "" && file != "" && file != "-" {
+			name = strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		}
+		if name == "" {
+			return fmt.Errorf("--name is required unless --file is given")
+		}
+
+		content, err := pageContent(cmd, "")
+		if err != nil {
+			return err
+		}
+
+		data := map[string]interface{}{
+			"name":           name,
+			"content":        content,
+			"content_format": "text/md",
+		}
+		if subTitle != "" {
+			data["sub_title"] = subTitle
+		}
+		if parentPage != "" {
+			data["parent_page_id"] = parentPage
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		var page api.DocPage
+		if err := client.V3().Post(docsEndpoint(docID, "pages"), bytes.NewReader(body), nil, &page); err != nil {
+			return fmt.Errorf("creating page: %w", err)
+		}
+
+		fmt.Printf("Page created: %s (ID: %s)\n", api.Or(page.Name, name), page.ID)
+		return nil
+	},
+}
+
+func init() {
+	docPageCmd.AddCommand(docPageCreateCmd)
+	docPageCreateCmd.Flags().StringP("name", "n", "", "Page name (default: file name)")
+	docPageCreateCmd.Flags().String("sub-title", "", "Page subtitle")
+	docPageCreateCmd.Flags().StringP("parent-page", "p", "", "Nest the page under this page ID")
+	docPageCreateCmd.Flags().StringP("file", "f", "", "Read the Markdown content from this file (- for stdin)")
+}
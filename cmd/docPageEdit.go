@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var docPageEditCmd = &cobra.Command{
+	Use:   "edit <doc-id> <page-id>",
+	Short: "Edit a document page",
+	Long: `Replace, append to or prepend to a page's content, and optionally rename
+it. The Markdown content is read from --file (--file - for stdin), or
+else written in $EDITOR; in replace mode the editor starts with the
+current content. Replacing a page with empty content is refused.
+
+Giving only --name or --sub-title, without --file, changes the page
+metadata and leaves the content alone.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		docID, pageID := args[0], args[1]
+		mode, _ := cmd.Flags().GetString("mode")
+		name, _ := cmd.Flags().GetString("name")
+		subTitle, _ := cmd.Flags().GetString("sub-title")
+		file, _ := cmd.Flags().GetString("file")
+
+		if mode != "replace" && mode != "append" && mode != "prepend" {
+			return fmt.Errorf("invalid --mode %q (expected replace, append or prepend)", mode)
+		}
+
+		data := map[string]interface{}{}
+		if name != "" {
+			data["name"] = name
+		}
+		if cmd.Flags().Changed("sub-title") {
+			data["sub_title"] = subTitle
+		}
+
+		metadataOnly := len(data) > 0 && file == ""
+		if !metadataOnly {
+			initial := ""
+			if mode == "replace" && file == "" {
+				var page api.DocPage
+				params := map[string]string{"content_format": "text/md"}
+				if err := client.V3().Get(docsEndpoint(docID, "pages", pageID), params, &page); err != nil {
+					return fmt.Errorf("getting page: %w", err)
+				}
+				initial = page.Content
+			}
+
+			content, err := pageContent(cmd, initial)
+			if err != nil {
+				return err
+			}
+			if content == "" && mode == "replace" {
+				return fmt.Errorf("refusing to replace the page with empty content")
+			}
+			data["content"] = content
+			data["content_edit_mode"] = mode
+			data["content_format"] = "text/md"
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		if err := client.V3().Put(docsEndpoint(docID, "pages", pageID), bytes.NewReader(body), nil, nil); err != nil {
+			return fmt.Errorf("updating page: %w", err)
+		}
+
+		fmt.Printf("Page updated: %s\n", pageID)
+		return nil
+	},
+}
+
+func init() {
+	docPageCmd.AddCommand(docPageEditCmd)
+	docPageEditCmd.Flags().StringP("mode", "m", "replace", "How to apply the content: replace, append or prepend")
+	docPageEditCmd.Flags().StringP("name", "n", "", "Rename the page")
+	docPageEditCmd.Flags().String("sub-title", "", "Change the page subtitle")
+	docPageEditCmd.Flags().StringP("file", "f", "", "Read the Markdown content from this file (- for stdin)")
+}
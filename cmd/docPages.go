@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var docPagesCmd = &cobra.Command{
+	Use:   "pages <doc-id>",
+	Short: "List the pages of a document",
+	Long: `Show a document's page hierarchy as a tree, with page IDs for "doc read --page".
+Archived pages are marked as such.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		docID := args[0]
+
+		doc, err := fetchDoc(docID)
+		if err != nil {
+			return fmt.Errorf("getting document: %w", err)
+		}
+		pages, err := fetchDocPages(docID, false)
+		if err != nil {
+			return fmt.Errorf("listing pages: %w", err)
+		}
+
+		if len(pages) == 0 {
+			fmt.Printf("No pages found in %s.\n", doc.Name)
+			return nil
+		}
+
+		fmt.Printf("%s (%s)\n", doc.Name, doc.ID)
+		fmt.Print(api.FormatPageTree(pages))
+
+		return nil
+	},
+}
+
+func init() {
+	docCmd.AddCommand(docPagesCmd)
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/otard95/clickup-cli/internal/api"
 	"github.com/spf13/cobra"
@@ -10,27 +11,59 @@ import (
 var docReadCmd = &cobra.Command{
 	Use:   "read <doc-id>",
 	Short: "Read a ClickUp document by ID",
-	Long:  `Retrieve and display a ClickUp document's content, metadata, and creator info.`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Retrieve and display a ClickUp document's metadata and the Markdown
+content of all its pages, each under a heading nested by page depth.
+Archived pages are left out.
+
+Use --page to show a single page; "doc pages" lists the page IDs.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		docID := args[0]
+		pageID, _ := cmd.Flags().GetString("page")
+
+		if pageID != "" {
+			var page api.DocPage
+			params := map[string]string{"content_format": "text/md"}
+			if err := client.V3().Get(docsEndpoint(docID, "pages", pageID), params, &page); err != nil {
+				return fmt.Errorf("reading page: %w", err)
+			}
+
+			fmt.Printf("%s\n", api.Or(page.Name, "Untitled"))
+			fmt.Printf("========================================\n\n")
+			fmt.Printf("ID:      %s\n", page.ID)
+			fmt.Printf("Updated: %s\n", api.FormatTimestamp(string(page.DateUpdated)))
+			fmt.Println()
+			fmt.Println(api.Or(strings.TrimSpace(page.Content), "(No content)"))
+			return nil
+		}
 
-		var doc api.Document
-		if err := client.Get(fmt.Sprintf("/doc/%s", docID), nil, &doc); err != nil {
+		doc, err := fetchDoc(docID)
+		if err != nil {
 			return fmt.Errorf("reading document: %w", err)
 		}
+		pages, err := fetchDocPages(docID, true)
+		if err != nil {
+			return fmt.Errorf("reading document pages: %w", err)
+		}
+		pages = api.LivePages(pages)
 
 		fmt.Printf("%s\n", doc.Name)
 		fmt.Printf("========================================\n\n")
 		fmt.Printf("ID:      %s\n", doc.ID)
-		fmt.Printf("Created: %s\n", api.FormatTimestamp(doc.DateCreated))
-		fmt.Printf("Creator: %s\n", doc.Creator.Username)
+		fmt.Printf("Created: %s\n", api.FormatTimestamp(string(doc.DateCreated)))
+		fmt.Printf("Creator: %s\n", userName(memberNames(), int64(doc.Creator)))
 		fmt.Println()
-		if doc.Content != "" {
-			fmt.Println(doc.Content)
-		} else {
+
+		if len(pages) == 0 {
 			fmt.Println("(No content)")
+			return nil
 		}
+		api.WalkPages(pages, func(p api.DocPage, depth int) {
+			fmt.Printf("%s %s\n\n", strings.Repeat("#", min(depth+1, 6)), api.Or(p.Name, "Untitled"))
+			if content := strings.TrimSpace(p.Content); content != "" {
+				fmt.Printf("%s\n\n", content)
+			}
+		})
 
 		return nil
 	},
@@ -38,4 +71,5 @@ var docReadCmd = &cobra.Command{
 
 func init() {
 	docCmd.AddCommand(docReadCmd)
+	docReadCmd.Flags().StringP("page", "p", "", "Show only this page")
 }
@@ -11,32 +11,33 @@ import (
 var docSearchCmd = &cobra.Command{
 	Use:   "search [query]",
 	Short: "Search for documents in the workspace",
-	Long:  `Find documents across the ClickUp workspace, optionally filtered by query.`,
+	Long: `Find documents across the ClickUp workspace, optionally filtered by a
+query matched against document names.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		query := ""
-		if len(args) > 0 {
-			query = strings.Join(args, " ")
-		}
+		query := strings.ToLower(strings.Join(args, " "))
 
-		params := map[string]string{}
-		if query != "" {
-			params["query"] = query
+		docs, err := listDocs()
+		if err != nil {
+			return fmt.Errorf("searching documents: %w", err)
 		}
 
-		var resp api.DocsResponse
-		if err := client.Get(fmt.Sprintf("/team/%s/docs", client.TeamID()), params, &resp); err != nil {
-			return fmt.Errorf("searching documents: %w", err)
+		var matches []api.Document
+		for _, d := range docs {
+			if strings.Contains(strings.ToLower(d.Name), query) {
+				matches = append(matches, d)
+			}
 		}
 
-		if len(resp.Docs) == 0 {
+		if len(matches) == 0 {
 			fmt.Println("No documents found.")
 			return nil
 		}
 
-		fmt.Printf("Found %d document(s):\n\n", len(resp.Docs))
-		for _, d := range resp.Docs {
+		names := memberNames()
+		fmt.Printf("Found %d document(s):\n\n", len(matches))
+		for _, d := range matches {
 			fmt.Printf("%s  %s  (created: %s, by: %s)\n",
-				d.ID, d.Name, api.FormatTimestamp(d.DateCreated), d.Creator.Username)
+				d.ID, d.Name, api.FormatTimestamp(string(d.DateCreated)), userName(names, int64(d.Creator)))
 		}
 
 		return nil
@@ -0,0 +1,447 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// syncManifestName is the file in a synced directory recording, per page,
+// its file and the content hashes on both sides at the last sync.
+const syncManifestName = ".clickup-sync.json"
+
+type syncManifest struct {
+	DocID string               `json:"doc_id"`
+	Pages map[string]syncState `json:"pages"`
+}
+
+type syncState struct {
+	Path       string `json:"path"`
+	LocalHash  string `json:"local_hash"`
+	RemoteHash string `json:"remote_hash"`
+}
+
+// localPage is a Markdown file found in a synced directory.
+type localPage struct {
+	path string // slash-separated, relative to the directory
+	meta map[string]string
+	body string
+}
+
+// contentHash identifies page content, ignoring surrounding whitespace.
+func contentHash(body string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(body)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// readLocalPages returns the Markdown files under dir, skipping hidden
+// files and directories.
+func readLocalPages(dir string) ([]localPage, error) {
+	var pages []localPage
+	err := filepath.WalkDir(dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if name != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || filepath.Ext(name) != ".md" {
+			return nil
+		}
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		meta, body := splitFrontMatter(data)
+		pages = append(pages, localPage{path: filepath.ToSlash(rel), meta: meta, body: body})
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return pages, err
+}
+
+var docSyncCmd = &cobra.Command{
+	Use:   "sync <dir> <doc-id>",
+	Short: "Sync a Markdown directory with a document",
+	Long: `Two-way sync between a directory of Markdown files and a document's
+pages. Local changes are pushed, remote changes are pulled, and new files
+and pages are created on the other side. Files use the same layout and
+front matter as "doc export"; the front matter id ties a file to its page.
+Links to other pages of the document are relative paths in the files and
+become app links again when pushed.
+
+The state at the last sync is kept in ` + syncManifestName + ` in the directory.
+A page changed on both sides since then is a conflict and is left alone
+unless --force picks a side: --force (or --force=local) pushes the local
+file, --force=remote overwrites it with the page.
+
+Pages cannot be deleted through the API, so deleting a file is reported
+but not synced. Files of archived pages are left alone. Use --dry-run to see what would happen.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, docID := args[0], args[1]
+		force, _ := cmd.Flags().GetString("force")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if force != "" && force != "local" && force != "remote" {
+			return fmt.Errorf("invalid --force %q (expected local or remote)", force)
+		}
+
+		manifest := syncManifest{DocID: docID, Pages: map[string]syncState{}}
+		manifestPath := filepath.Join(dir, syncManifestName)
+		if data, err := os.ReadFile(manifestPath); err == nil {
+			if err := json.Unmarshal(data, &manifest); err != nil {
+				return fmt.Errorf("reading %s: %w", syncManifestName, err)
+			}
+			if manifest.DocID != docID {
+				return fmt.Errorf("%s is synced with document %s, not %s", dir, manifest.DocID, docID)
+			}
+			if manifest.Pages == nil {
+				manifest.Pages = map[string]syncState{}
+			}
+		}
+
+		doc, err := fetchDoc(docID)
+		if err != nil {
+			return fmt.Errorf("getting document: %w", err)
+		}
+		pages, err := fetchDocPages(docID, true)
+		if err != nil {
+			return fmt.Errorf("reading pages: %w", err)
+		}
+		plan := planExport(*doc, pages, "")
+		remote := map[string]exportPage{}
+		for _, p := range plan {
+			remote[p.page.ID] = p
+		}
+		// Pages the API still returns but the plan leaves out are archived,
+		// or under an archived page.
+		archived := map[string]bool{}
+		api.WalkPages(pages, func(p api.DocPage, _ int) {
+			if _, ok := remote[p.ID]; !ok {
+				archived[p.ID] = true
+			}
+		})
+
+		files, err := readLocalPages(dir)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", dir, err)
+		}
+		local := map[string]localPage{}
+		var newFiles []localPage
+		taken := map[string]bool{}
+		for _, f := range files {
+			taken[f.path] = true
+			if id := f.meta["id"]; id != "" {
+				local[id] = f
+			} else {
+				newFiles = append(newFiles, f)
+			}
+		}
+
+		// Links between pages are relative file paths locally and app links
+		// in ClickUp, as in "doc export". Contents are compared in the local
+		// form, with paths to where the files actually are.
+		targets := linkTargets(plan)
+		for id, f := range local {
+			if _, ok := remote[id]; ok {
+				targets[id] = f.path
+			}
+		}
+		pageIDs := map[string]string{}
+		for _, p := range plan {
+			pageIDs[targets[p.page.ID]] = p.page.ID
+		}
+		if len(plan) > 0 {
+			targets[doc.ID] = targets[plan[0].page.ID]
+		}
+		toLocal := func(content, at string) string {
+			return rewriteDocLinks(content, at, targets)
+		}
+		toRemote := func(body, at string) string {
+			return restoreDocLinks(strings.TrimSpace(body), at, docID, pageIDs)
+		}
+
+		creator := userName(memberNames(), int64(doc.Creator))
+		var pushed, pulled, created, conflicts, failed int
+		report := func(action, path, note string) {
+			if note != "" {
+				path += ": " + note
+			}
+			fmt.Printf("%-8s %s\n", action, path)
+		}
+
+		// push updates a page from its file and returns the hash of what
+		// ClickUp stored, which may be reformatted from what was sent.
+		push := func(id string, f localPage, r exportPage) (string, error) {
+			data := map[string]interface{}{
+				"content":           toRemote(f.body, f.path),
+				"content_edit_mode": "replace",
+				"content_format":    "text/md",
+			}
+			if name := f.meta["name"]; name != "" && name != r.page.Name {
+				data["name"] = name
+			}
+			body, err := json.Marshal(data)
+			if err != nil {
+				return "", err
+			}
+			if err := client.V3().Put(docsEndpoint(docID, "pages", id), bytes.NewReader(body), nil, nil); err != nil {
+				return "", err
+			}
+			page, err := fetchDocPage(docID, id)
+			if err != nil {
+				return "", fmt.Errorf("rereading page: %w", err)
+			}
+			return contentHash(toLocal(page.Content, f.path)), nil
+		}
+		pull := func(p exportPage) error {
+			_, err := writeIfChanged(filepath.Join(dir, filepath.FromSlash(p.path)), renderExportPage(p, creator, toLocal(p.page.Content, p.path)))
+			return err
+		}
+
+		ids := map[string]bool{}
+		for id := range remote {
+			ids[id] = true
+		}
+		for id := range local {
+			ids[id] = true
+		}
+		for id := range manifest.Pages {
+			ids[id] = true
+		}
+		sorted := make([]string, 0, len(ids))
+		for id := range ids {
+			sorted = append(sorted, id)
+		}
+		sort.Strings(sorted)
+
+		for _, id := range sorted {
+			base, synced := manifest.Pages[id]
+			f, hasLocal := local[id]
+			r, hasRemote := remote[id]
+
+			switch {
+			case !hasRemote && !hasLocal:
+				delete(manifest.Pages, id)
+				continue
+
+			case !hasRemote && archived[id]:
+				// Archiving can be undone in ClickUp; deleting the file cannot.
+				report("skip", f.path, "page is archived; unarchive it or delete the file")
+				continue
+
+			case !hasRemote:
+				if synced && contentHash(f.body) == base.LocalHash {
+					report("delete", f.path, "page was deleted remotely")
+					if !dryRun {
+						if err := os.Remove(filepath.Join(dir, filepath.FromSlash(f.path))); err != nil {
+							report("error", f.path, err.Error())
+							failed++
+							continue
+						}
+						delete(manifest.Pages, id)
+					}
+				} else {
+					report("conflict", f.path, "page was deleted remotely but the file changed")
+					conflicts++
+				}
+				continue
+
+			case !hasLocal:
+				if synced {
+					report("skip", base.Path, "file was deleted locally; delete the page in ClickUp")
+					continue
+				}
+				// A new remote page; keep the planned path unless a file
+				// without front matter already uses it.
+				if taken[r.path] {
+					report("conflict", r.path, "new page would overwrite an untracked file")
+					conflicts++
+					continue
+				}
+				report("pull", r.path, "new page")
+				if !dryRun {
+					if err := pull(r); err != nil {
+						report("error", r.path, err.Error())
+						failed++
+						continue
+					}
+					hash := contentHash(toLocal(r.page.Content, r.path))
+					manifest.Pages[id] = syncState{Path: r.path, LocalHash: hash, RemoteHash: hash}
+				}
+				pulled++
+				continue
+			}
+
+			r.path = f.path
+			localHash, remoteHash := contentHash(f.body), contentHash(toLocal(r.page.Content, f.path))
+			localChanged := !synced || localHash != base.LocalHash
+			remoteChanged := !synced || remoteHash != base.RemoteHash
+
+			action := ""
+			switch {
+			case localHash == remoteHash:
+				// Already in step, e.g. on the first sync of an export.
+			case localChanged && remoteChanged:
+				if force == "" {
+					report("conflict", f.path, "changed locally and remotely; use --force=local or --force=remote")
+					conflicts++
+					continue
+				}
+				action = "push"
+				if force == "remote" {
+					action = "pull"
+				}
+			case localChanged:
+				action = "push"
+			case remoteChanged:
+				action = "pull"
+			}
+
+			if action != "" {
+				report(action, f.path, "")
+				if dryRun {
+					if action == "push" {
+						pushed++
+					} else {
+						pulled++
+					}
+					continue
+				}
+				var err error
+				var stored string
+				if action == "push" {
+					stored, err = push(id, f, r)
+				} else {
+					err = pull(r)
+				}
+				if err != nil {
+					report("error", f.path, err.Error())
+					failed++
+					continue
+				}
+				if action == "push" {
+					remoteHash = stored
+					pushed++
+				} else {
+					localHash = remoteHash
+					pulled++
+				}
+			}
+			manifest.Pages[id] = syncState{Path: f.path, LocalHash: localHash, RemoteHash: remoteHash}
+		}
+
+		// Files without an id become new pages, nested under the page whose
+		// file sits beside their directory.
+		pathIDs := map[string]string{}
+		for id, s := range manifest.Pages {
+			pathIDs[s.Path] = id
+		}
+		sort.Slice(newFiles, func(i, j int) bool {
+			return strings.Count(newFiles[i].path, "/") < strings.Count(newFiles[j].path, "/")
+		})
+		for _, f := range newFiles {
+			name := f.meta["name"]
+			if name == "" {
+				name = strings.TrimSuffix(path.Base(f.path), ".md")
+			}
+			report("create", f.path, "new page "+name)
+			created++
+			if dryRun {
+				continue
+			}
+
+			data := map[string]interface{}{
+				"name":           name,
+				"content":        toRemote(f.body, f.path),
+				"content_format": "text/md",
+			}
+			if parent, ok := pathIDs[path.Dir(f.path)+".md"]; ok {
+				data["parent_page_id"] = parent
+			}
+			body, err := json.Marshal(data)
+			if err != nil {
+				return fmt.Errorf("encoding request: %w", err)
+			}
+			var page api.DocPage
+			if err := client.V3().Post(docsEndpoint(docID, "pages"), bytes.NewReader(body), nil, &page); err != nil {
+				report("error", f.path, err.Error())
+				failed++
+				continue
+			}
+			if page.Name == "" {
+				page.Name = name
+			}
+
+			// Record the new page ID in the file's front matter.
+			page.Content = f.body
+			if err := pull(exportPage{doc: *doc, page: page, path: f.path}); err != nil {
+				report("error", f.path, err.Error())
+				failed++
+				continue
+			}
+			remoteHash := contentHash(f.body)
+			if stored, err := fetchDocPage(docID, page.ID); err == nil {
+				remoteHash = contentHash(toLocal(stored.Content, f.path))
+			}
+			manifest.Pages[page.ID] = syncState{Path: f.path, LocalHash: contentHash(f.body), RemoteHash: remoteHash}
+			pathIDs[f.path] = page.ID
+			pageIDs[f.path] = page.ID
+		}
+
+		if !dryRun {
+			data, err := json.MarshalIndent(manifest, "", "  ")
+			if err != nil {
+				return fmt.Errorf("encoding manifest: %w", err)
+			}
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return fmt.Errorf("creating %s: %w", dir, err)
+			}
+			if err := os.WriteFile(manifestPath, append(data, '\n'), 0o644); err != nil {
+				return fmt.Errorf("writing manifest: %w", err)
+			}
+		}
+
+		verb := "Synced"
+		if dryRun {
+			verb = "Would sync"
+		}
+		fmt.Printf("\n%s %s with %s: %d pushed, %d pulled, %d created, %d conflict(s)\n",
+			verb, dir, doc.Name, pushed, pulled, created, conflicts)
+		if conflicts > 0 || failed > 0 {
+			cmd.SilenceUsage = true
+			if failed > 0 {
+				return fmt.Errorf("%d page(s) failed to sync", failed)
+			}
+			return fmt.Errorf("%d conflict(s) left unsynced", conflicts)
+		}
+		return nil
+	},
+}
+
+func init() {
+	docCmd.AddCommand(docSyncCmd)
+	docSyncCmd.Flags().String("force", "", "Resolve conflicts in favour of local or remote")
+	docSyncCmd.Flags().Lookup("force").NoOptDefVal = "local"
+	docSyncCmd.Flags().BoolP("dry-run", "n", false, "Show what would be synced without changing anything")
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// readText returns the text for commands that accept free-form content:
+// the joined arguments if any, otherwise stdin when it is piped, otherwise
+// whatever the user writes in $EDITOR (pre-filled with initial).
+func readText(args []string, initial string) (string, error) {
+	if len(args) > 0 {
+		return strings.Join(args, " "), nil
+	}
+
+	if stdinPiped() {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("reading stdin: %w", err)
+		}
+		text := strings.TrimSpace(string(data))
+		if text == "" {
+			return "", fmt.Errorf("aborted: empty text")
+		}
+		return text, nil
+	}
+
+	text, err := editText(initial)
+	if err != nil {
+		return "", err
+	}
+	if text == "" {
+		return "", fmt.Errorf("aborted: empty text")
+	}
+	return text, nil
+}
+
+// stdinPiped reports whether stdin is a pipe or file rather than a terminal.
+func stdinPiped() bool {
+	stat, err := os.Stdin.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice == 0
+}
+
+// editText opens initial in the user's editor and returns the saved
+// content with surrounding whitespace trimmed.
+func editText(initial string) (string, error) {
+	editor := os.Getenv("VISUAL")
+	if strings.TrimSpace(editor) == "" {
+		editor = os.Getenv("EDITOR")
+	}
+
+	f, err := os.CreateTemp("", "clickup-*.md")
+	if err != nil {
+		return "", fmt.Errorf("creating temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.WriteString(initial); err != nil {
+		f.Close()
+		return "", fmt.Errorf("writing temp file: %w", err)
+	}
+	f.Close()
+
+	// The editor command may carry arguments (e.g. "code --wait").
+	parts := strings.Fields(editor)
+	if len(parts) == 0 {
+		parts = []string{"vi"}
+	}
+	c := exec.Command(parts[0], append(parts[1:], f.Name())...)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		return "", fmt.Errorf("running editor: %w", err)
+	}
+
+	data, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", fmt.Errorf("reading temp file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var folderCmd = &cobra.Command{
+	Use:   "folder",
+	Short: "Manage ClickUp folders",
+	Long:  `Work with the folders of a space and the lists they contain.`,
+}
+
+func init() {
+	rootCmd.AddCommand(folderCmd)
+}
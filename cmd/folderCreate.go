@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var folderCreateCmd = &cobra.Command{
+	Use:   "create <space-id> <name>",
+	Short: "Create a folder in a space",
+	Long:  `Create an empty folder in a space; add lists with "list create --folder".`,
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceID := args[0]
+		name := strings.Join(args[1:], " ")
+
+		body, err := json.Marshal(map[string]string{"name": name})
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		var folder api.Folder
+		if err := client.Post(fmt.Sprintf("/space/%s/folder", spaceID), bytes.NewReader(body), nil, &folder); err != nil {
+			return fmt.Errorf("creating folder: %w", err)
+		}
+
+		fmt.Printf("Folder created: %s (ID: %s)\n", folder.Name, folder.ID)
+		return nil
+	},
+}
+
+func init() {
+	folderCmd.AddCommand(folderCreateCmd)
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var folderDeleteCmd = &cobra.Command{
+	Use:   "delete <folder-id>",
+	Short: "Delete a folder",
+	Long: `Permanently delete a folder with all its lists and tasks. Asks for
+confirmation unless --yes is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		folderID := args[0]
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		if !yes {
+			var folder api.Folder
+			if err := client.Get(fmt.Sprintf("/folder/%s", folderID), nil, &folder); err != nil {
+				return fmt.Errorf("getting folder: %w", err)
+			}
+			ok, err := confirm(fmt.Sprintf("Delete folder %q (%s) with %d list(s) and all their tasks?", folder.Name, folderID, len(folder.Lists)))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Aborted.")
+				return nil
+			}
+		}
+
+		if err := client.Delete(fmt.Sprintf("/folder/%s", folderID), nil, nil); err != nil {
+			return fmt.Errorf("deleting folder: %w", err)
+		}
+
+		fmt.Printf("Folder deleted: %s\n", folderID)
+		return nil
+	},
+}
+
+func init() {
+	folderCmd.AddCommand(folderDeleteCmd)
+	folderDeleteCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+}
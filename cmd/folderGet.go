@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var folderGetCmd = &cobra.Command{
+	Use:   "get <folder-id>",
+	Short: "Get detailed information about a folder",
+	Long:  `Show folder metadata, hidden state and its lists with task counts.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		folderID := args[0]
+
+		var folder api.Folder
+		if err := client.Get(fmt.Sprintf("/folder/%s", folderID), nil, &folder); err != nil {
+			return fmt.Errorf("getting folder: %w", err)
+		}
+
+		fmt.Printf("%s\n", folder.Name)
+		fmt.Printf("========================================\n\n")
+		fmt.Printf("ID:                %s\n", folder.ID)
+		fmt.Printf("Space:             %s\n", folder.Space.Name)
+		fmt.Printf("Task Count:        %d\n", int(folder.TaskCount))
+		fmt.Printf("Hidden:            %t\n", folder.Hidden)
+		fmt.Printf("Override Statuses: %t\n", folder.OverrideStatuses)
+		fmt.Println()
+
+		if len(folder.Lists) == 0 {
+			fmt.Println("No lists in this folder.")
+			return nil
+		}
+
+		fmt.Printf("Lists (%d):\n", len(folder.Lists))
+		for _, l := range folder.Lists {
+			fmt.Printf("  - %s (ID: %s) - %d tasks\n", l.Name, l.ID, int(l.TaskCount))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	folderCmd.AddCommand(folderGetCmd)
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var folderListsCmd = &cobra.Command{
+	Use:   "lists <folder-id>",
+	Short: "Get all lists in a folder",
+	Long:  `Get the lists within a folder with their task counts and status.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		folderID := args[0]
+		archived, _ := cmd.Flags().GetBool("archived")
+
+		params := map[string]string{}
+		if archived {
+			params["archived"] = "true"
+		}
+
+		var resp api.ListsResponse
+		if err := client.Get(fmt.Sprintf("/folder/%s/list", folderID), params, &resp); err != nil {
+			return fmt.Errorf("getting lists: %w", err)
+		}
+
+		if len(resp.Lists) == 0 {
+			fmt.Println("No lists found in this folder.")
+			return nil
+		}
+
+		fmt.Printf("Found %d list(s) in folder:\n\n", len(resp.Lists))
+		for _, l := range resp.Lists {
+			status := ""
+			if l.Status != nil && l.Status.Status != "" {
+				status = fmt.Sprintf(" [%s]", l.Status.Status)
+			}
+			fmt.Printf("%s  %s%s  (%d tasks)\n", l.ID, l.Name, status, int(l.TaskCount))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	folderCmd.AddCommand(folderListsCmd)
+	folderListsCmd.Flags().BoolP("archived", "a", false, "Show archived lists")
+}
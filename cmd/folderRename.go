@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var folderRenameCmd = &cobra.Command{
+	Use:   "rename <folder-id> <name>",
+	Short: "Rename a folder",
+	Long:  `Change the name of a folder.`,
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		folderID := args[0]
+		name := strings.Join(args[1:], " ")
+
+		body, err := json.Marshal(map[string]string{"name": name})
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		if err := client.Put(fmt.Sprintf("/folder/%s", folderID), bytes.NewReader(body), nil, nil); err != nil {
+			return fmt.Errorf("renaming folder: %w", err)
+		}
+
+		fmt.Printf("Folder renamed: %s -> %s\n", folderID, name)
+		return nil
+	},
+}
+
+func init() {
+	folderCmd.AddCommand(folderRenameCmd)
+}
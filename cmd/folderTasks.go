@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var folderTasksCmd = &cobra.Command{
+	Use:   "tasks <folder-id>",
+	Short: "Get all tasks in a folder",
+	Long: `Get all tasks across the lists of a folder, grouped by list. Supports
+filtering by assignee using numeric user IDs (comma-separated for
+multiple).`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		folderID := args[0]
+		archived, _ := cmd.Flags().GetBool("archived")
+		assignees, _ := cmd.Flags().GetString("assignees")
+
+		var folder api.Folder
+		if err := client.Get(fmt.Sprintf("/folder/%s", folderID), nil, &folder); err != nil {
+			return fmt.Errorf("getting folder: %w", err)
+		}
+
+		params := map[string]string{}
+		if archived {
+			params["archived"] = "true"
+		}
+		var ids []string
+		for _, id := range strings.Split(assignees, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				ids = append(ids, id)
+			}
+		}
+
+		total := 0
+		for _, l := range folder.Lists {
+			endpoint := fmt.Sprintf("/list/%s/task", l.ID)
+			if len(ids) > 0 {
+				endpoint = api.SetQueryArray(endpoint, "assignees[]", ids)
+			}
+
+			tasks, err := listTasksAll(endpoint, params)
+			if err != nil {
+				return fmt.Errorf("getting tasks in list %s: %w", l.Name, err)
+			}
+			if len(tasks) == 0 {
+				continue
+			}
+
+			fmt.Printf("%s (ID: %s) - %d task(s):\n\n", l.Name, l.ID, len(tasks))
+			for _, t := range tasks {
+				fmt.Println(api.FormatTaskSummary(t))
+			}
+			fmt.Println()
+			total += len(tasks)
+		}
+
+		if total == 0 {
+			fmt.Println("No tasks found in this folder.")
+			return nil
+		}
+		fmt.Printf("Total: %d task(s) in %d list(s)\n", total, len(folder.Lists))
+
+		return nil
+	},
+}
+
+func init() {
+	folderCmd.AddCommand(folderTasksCmd)
+	folderTasksCmd.Flags().BoolP("archived", "a", false, "Include archived tasks")
+	folderTasksCmd.Flags().StringP("assignees", "A", "", "Filter by assignee user IDs (comma-separated)")
+}
@@ -7,7 +7,7 @@ import (
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Manage ClickUp lists",
-	Long:  `View list details and tasks within lists.`,
+	Long:  `Create, rename and delete lists and view list details and tasks.`,
 }
 
 func init() {
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var listCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a list in a folder or space",
+	Long: `Create a list inside a folder with --folder, or a folderless list directly
+in a space with --space.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := strings.Join(args, " ")
+		folderID, _ := cmd.Flags().GetString("folder")
+		spaceID, _ := cmd.Flags().GetString("space")
+		description, _ := cmd.Flags().GetString("description")
+
+		var endpoint string
+		switch {
+		case folderID != "" && spaceID != "":
+			return fmt.Errorf("only one of --folder or --space may be given")
+		case folderID != "":
+			endpoint = fmt.Sprintf("/folder/%s/list", folderID)
+		case spaceID != "":
+			endpoint = fmt.Sprintf("/space/%s/list", spaceID)
+		default:
+			return fmt.Errorf("--folder or --space is required")
+		}
+
+		data := map[string]string{"name": name}
+		if description != "" {
+			data["content"] = description
+		}
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		var list api.ListInfo
+		if err := client.Post(endpoint, bytes.NewReader(body), nil, &list); err != nil {
+			return fmt.Errorf("creating list: %w", err)
+		}
+
+		fmt.Printf("List created: %s (ID: %s)\n", list.Name, list.ID)
+		return nil
+	},
+}
+
+func init() {
+	listCmd.AddCommand(listCreateCmd)
+	listCreateCmd.Flags().StringP("folder", "f", "", "Create the list in this folder")
+	listCreateCmd.Flags().StringP("space", "S", "", "Create a folderless list in this space")
+	listCreateCmd.Flags().StringP("description", "d", "", "List description")
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var listDeleteCmd = &cobra.Command{
+	Use:   "delete <list-id>",
+	Short: "Delete a list",
+	Long: `Permanently delete a list with all its tasks. Asks for confirmation
+unless --yes is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		listID := args[0]
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		if !yes {
+			var list api.ListInfo
+			if err := client.Get(fmt.Sprintf("/list/%s", listID), nil, &list); err != nil {
+				return fmt.Errorf("getting list info: %w", err)
+			}
+			ok, err := confirm(fmt.Sprintf("Delete list %q (%s) with its %d task(s)?", list.Name, listID, int(list.TaskCount)))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Aborted.")
+				return nil
+			}
+		}
+
+		if err := client.Delete(fmt.Sprintf("/list/%s", listID), nil, nil); err != nil {
+			return fmt.Errorf("deleting list: %w", err)
+		}
+
+		fmt.Printf("List deleted: %s\n", listID)
+		return nil
+	},
+}
+
+func init() {
+	listCmd.AddCommand(listDeleteCmd)
+	listDeleteCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+}
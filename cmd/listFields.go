@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var listFieldsCmd = &cobra.Command{
+	Use:   "fields <list-id>",
+	Short: "List the custom fields available in a list",
+	Long:  `Show the custom field definitions accessible from a list, including dropdown and label options.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		listID := args[0]
+
+		var resp api.FieldsResponse
+		if err := client.Get(fmt.Sprintf("/list/%s/field", listID), nil, &resp); err != nil {
+			return fmt.Errorf("getting custom fields: %w", err)
+		}
+
+		if len(resp.Fields) == 0 {
+			fmt.Println("No custom fields found for this list.")
+			return nil
+		}
+
+		fmt.Printf("Found %d custom field(s):\n\n", len(resp.Fields))
+		for _, f := range resp.Fields {
+			required := ""
+			if f.Required {
+				required = " [required]"
+			}
+			fmt.Printf("%s  (%s)%s\n", f.Name, f.Type, required)
+			fmt.Printf("  ID: %s\n", f.ID)
+			if len(f.TypeConfig.Options) > 0 {
+				fmt.Printf("  Options: %s\n", optionNames(&f))
+			}
+			fmt.Println()
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	listCmd.AddCommand(listFieldsCmd)
+}
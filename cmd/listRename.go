@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var listRenameCmd = &cobra.Command{
+	Use:   "rename <list-id> <name>",
+	Short: "Rename a list",
+	Long:  `Change the name of a list.`,
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		listID := args[0]
+		name := strings.Join(args[1:], " ")
+
+		body, err := json.Marshal(map[string]string{"name": name})
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		if err := client.Put(fmt.Sprintf("/list/%s", listID), bytes.NewReader(body), nil, nil); err != nil {
+			return fmt.Errorf("renaming list: %w", err)
+		}
+
+		fmt.Printf("List renamed: %s -> %s\n", listID, name)
+		return nil
+	},
+}
+
+func init() {
+	listCmd.AddCommand(listRenameCmd)
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+)
+
+// teamMembers returns the members of the configured workspace.
+func teamMembers() ([]api.User, error) {
+	var resp api.TeamsResponse
+	if err := client.Get("/team", nil, &resp); err != nil {
+		return nil, fmt.Errorf("getting workspace members: %w", err)
+	}
+	for _, t := range resp.Teams {
+		if t.ID == client.TeamID() {
+			users := make([]api.User, len(t.Members))
+			for i, m := range t.Members {
+				users[i] = m.User
+			}
+			return users, nil
+		}
+	}
+	return nil, fmt.Errorf("workspace %s not found for this API token", client.TeamID())
+}
+
+// resolveUserIDs converts numeric user IDs, usernames or email addresses
+// into user IDs. Members are only fetched if a non-numeric value is given.
+func resolveUserIDs(values []string) ([]int, error) {
+	var members []api.User
+	ids := make([]int, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if id, err := strconv.Atoi(v); err == nil {
+			ids = append(ids, id)
+			continue
+		}
+
+		if members == nil {
+			var err error
+			if members, err = teamMembers(); err != nil {
+				return nil, err
+			}
+		}
+
+		var matches []api.User
+		for _, m := range members {
+			if strings.EqualFold(m.Username, v) || strings.EqualFold(m.Email, v) {
+				matches = append(matches, m)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("no workspace member named %q", v)
+		case 1:
+			ids = append(ids, matches[0].ID)
+		default:
+			return nil, fmt.Errorf("%q matches %d workspace members; use a user ID or email", v, len(matches))
+		}
+	}
+	return ids, nil
+}
+
+// memberNames maps user IDs to usernames for display. It is empty if the
+// members cannot be fetched, so callers fall back to showing IDs.
+func memberNames() map[int64]string {
+	names := map[int64]string{}
+	members, err := teamMembers()
+	if err != nil {
+		return names
+	}
+	for _, m := range members {
+		names[int64(m.ID)] = m.Username
+	}
+	return names
+}
+
+// userName returns the username for id, or the ID itself if unknown.
+func userName(names map[int64]string, id int64) string {
+	if name, ok := names[id]; ok && name != "" {
+		return name
+	}
+	return strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// confirm asks a yes/no question on stderr and reports whether the answer
+// was yes. It fails when stdin is not a terminal, so scripts have to pass
+// --yes explicitly.
+func confirm(question string) (bool, error) {
+	if stdinPiped() {
+		return false, fmt.Errorf("cannot ask for confirmation: stdin is not a terminal (use --yes)")
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, fmt.Errorf("reading answer: %w", err)
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
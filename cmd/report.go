@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Reports across many tasks",
+	Long:  `Aggregate reports over tasks in a list, space or search.`,
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var reportEstimatesCmd = &cobra.Command{
+	Use:   "estimates [query]",
+	Short: "Compare time estimates with tracked time",
+	Long: `List tasks with their time estimate, tracked time, variance and variance
+percentage, rolled up per assignee and per list. Tasks that overran their
+estimate by more than --threshold percent are marked with "!".
+
+Tasks are selected like "task search": by --list, --space, the other
+filters and an optional text query. Closed tasks and subtasks are
+included by default. Only tasks with an estimate count towards the
+variance; a task with several assignees counts towards each of them.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		threshold, _ := cmd.Flags().GetFloat64("threshold")
+		overOnly, _ := cmd.Flags().GetBool("over")
+		assignee, _ := cmd.Flags().GetString("assignee")
+
+		f := taskFilter{Subtasks: true}
+		f.Query = strings.Join(args, " ")
+		f.ListID, _ = cmd.Flags().GetString("list")
+		f.SpaceID, _ = cmd.Flags().GetString("space")
+		f.Status, _ = cmd.Flags().GetString("status")
+		f.Tag, _ = cmd.Flags().GetString("tag")
+		f.Closed, _ = cmd.Flags().GetBool("closed")
+		if assignee != "" {
+			ids, err := resolveUserIDs([]string{assignee})
+			if err != nil {
+				return err
+			}
+			f.Assignee = strconv.Itoa(ids[0])
+		}
+		if f.empty() {
+			return fmt.Errorf("specify --list, --space, another filter or a search query")
+		}
+
+		tasks, err := searchTasks(f)
+		if err != nil {
+			return fmt.Errorf("searching tasks: %w", err)
+		}
+
+		var rows []api.EstimateTotals
+		var byAssignee, byList api.EstimateRollup
+		var total api.EstimateTotals
+		var unestimated int
+		var unestimatedMs int64
+		for _, t := range tasks {
+			estimateMs, spentMs, ok := api.TaskEstimate(t)
+			if !ok {
+				if spentMs > 0 {
+					unestimated++
+					unestimatedMs += spentMs
+				}
+				continue
+			}
+
+			row := api.EstimateTotals{Key: estimateTaskLabel(t), Tasks: 1, EstimateMs: estimateMs, SpentMs: spentMs}
+			over := row.VariancePct() > threshold
+			if over {
+				row.Overruns = 1
+				total.Overruns++
+			}
+			if over || !overOnly {
+				rows = append(rows, row)
+			}
+
+			total.Tasks++
+			total.EstimateMs += estimateMs
+			total.SpentMs += spentMs
+			if len(t.Assignees) == 0 {
+				byAssignee.Add("(unassigned)", estimateMs, spentMs, over)
+			}
+			for _, a := range t.Assignees {
+				byAssignee.Add(a.Username, estimateMs, spentMs, over)
+			}
+			byList.Add(api.Or(t.List.Name, t.List.ID), estimateMs, spentMs, over)
+		}
+
+		if total.Tasks == 0 {
+			fmt.Printf("No estimated tasks found among %d task(s).\n", len(tasks))
+			return nil
+		}
+
+		fmt.Printf("Estimates vs. tracked time for %d estimated task(s):\n\n", total.Tasks)
+
+		api.SortByVariance(rows)
+		if len(rows) > 0 {
+			fmt.Print(formatEstimateTasks(rows, threshold))
+		} else {
+			fmt.Printf("No tasks overran by more than %.0f%%.\n", threshold)
+		}
+		fmt.Println()
+
+		fmt.Println("By assignee:")
+		fmt.Println(api.Indent(api.FormatEstimateTable("ASSIGNEE", byAssignee.Sorted()), "  "))
+		fmt.Println("By list:")
+		fmt.Println(api.Indent(api.FormatEstimateTable("LIST", byList.Sorted()), "  "))
+
+		fmt.Printf("Total: estimated %s, tracked %s, variance %s (%s)\n",
+			api.FormatDurationMs(total.EstimateMs), api.FormatDurationMs(total.SpentMs),
+			api.FormatVarianceMs(total.VarianceMs()), api.FormatVariancePct(total.VariancePct()))
+		fmt.Printf("%d task(s) overran by more than %.0f%%\n", total.Overruns, threshold)
+		if unestimated > 0 {
+			fmt.Printf("%d task(s) without an estimate have %s tracked\n", unestimated, api.FormatDurationMs(unestimatedMs))
+		}
+
+		return nil
+	},
+}
+
+// estimateTaskLabel identifies a task by custom ID if it has one.
+func estimateTaskLabel(t api.Task) string {
+	id := t.ID
+	if t.CustomID != nil && *t.CustomID != "" {
+		id = *t.CustomID
+	}
+	return fmt.Sprintf("%s %s", id, t.Name)
+}
+
+// formatEstimateTasks renders one line per task, marking overruns.
+func formatEstimateTasks(rows []api.EstimateTotals, threshold float64) string {
+	width := len("TASK")
+	for _, r := range rows {
+		width = max(width, len([]rune(r.Key)))
+	}
+	width = min(width, 60)
+
+	var b strings.Builder
+	line := func(mark, key, estimate, tracked, variance, pct string) {
+		if r := []rune(key); len(r) > width {
+			key = string(r[:width-1]) + "…"
+		}
+		fmt.Fprintf(&b, "%1s %-*s  %9s  %9s  %9s  %6s\n", mark, width, key, estimate, tracked, variance, pct)
+	}
+
+	line("", "TASK", "ESTIMATE", "TRACKED", "VARIANCE", "VAR%")
+	for _, r := range rows {
+		mark := ""
+		if r.VariancePct() > threshold {
+			mark = "!"
+		}
+		line(mark, r.Key, api.FormatDurationMs(r.EstimateMs), api.FormatDurationMs(r.SpentMs),
+			api.FormatVarianceMs(r.VarianceMs()), api.FormatVariancePct(r.VariancePct()))
+	}
+	return b.String()
+}
+
+func init() {
+	reportCmd.AddCommand(reportEstimatesCmd)
+	reportEstimatesCmd.Flags().StringP("list", "l", "", "Tasks in this list")
+	reportEstimatesCmd.Flags().StringP("space", "S", "", "Tasks in this space")
+	reportEstimatesCmd.Flags().StringP("assignee", "a", "", "Tasks assigned to this user (ID, username or email)")
+	reportEstimatesCmd.Flags().StringP("status", "s", "", "Tasks with this status")
+	reportEstimatesCmd.Flags().StringP("tag", "T", "", "Tasks with this tag")
+	reportEstimatesCmd.Flags().Bool("closed", true, "Include closed tasks (--closed=false for open tasks only)")
+	reportEstimatesCmd.Flags().Float64P("threshold", "t", 20, "Flag tasks that overran by more than this percentage")
+	reportEstimatesCmd.Flags().Bool("over", false, "Only list tasks over the threshold")
+}
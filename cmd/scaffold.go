@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var scaffoldCmd = &cobra.Command{
+	Use:   "scaffold",
+	Short: "Create project structures from templates",
+	Long:  `Declaratively create folders, lists, tasks, checklists and tags from a YAML template.`,
+}
+
+// scaffoldTemplate is a project layout to create in a space.
+type scaffoldTemplate struct {
+	Variables map[string]string `yaml:"variables"`
+	Tags      []scaffoldTag     `yaml:"tags"`
+	Folders   []scaffoldFolder  `yaml:"folders"`
+	Lists     []scaffoldList    `yaml:"lists"`
+}
+
+type scaffoldTag struct {
+	Name string `yaml:"name"`
+	Fg   string `yaml:"fg"`
+	Bg   string `yaml:"bg"`
+}
+
+type scaffoldFolder struct {
+	Name  string         `yaml:"name"`
+	Lists []scaffoldList `yaml:"lists"`
+}
+
+type scaffoldList struct {
+	Name        string         `yaml:"name"`
+	Description string         `yaml:"description"`
+	Tasks       []scaffoldTask `yaml:"tasks"`
+}
+
+type scaffoldTask struct {
+	Name        string              `yaml:"name"`
+	Description string              `yaml:"description"`
+	Status      string              `yaml:"status"`
+	Priority    string              `yaml:"priority"`
+	Estimate    string              `yaml:"estimate"`
+	Assignees   []string            `yaml:"assignees"`
+	Tags        []string            `yaml:"tags"`
+	Checklists  []scaffoldChecklist `yaml:"checklists"`
+	Subtasks    []scaffoldTask      `yaml:"subtasks"`
+}
+
+type scaffoldChecklist struct {
+	Name  string   `yaml:"name"`
+	Items []string `yaml:"items"`
+}
+
+// scaffoldVar matches a {{ name }} variable reference.
+var scaffoldVar = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// loadScaffoldTemplate reads a template and substitutes {{ name }}
+// references in every string with the template's variables, overridden by
+// vars given as name=value. Unknown variables are an error.
+func loadScaffoldTemplate(path string, vars []string) (*scaffoldTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading template: %w", err)
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	var defaults struct {
+		Variables map[string]string `yaml:"variables"`
+	}
+	if err := root.Decode(&defaults); err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+
+	values := map[string]string{}
+	for k, v := range defaults.Variables {
+		values[k] = v
+	}
+	for _, v := range vars {
+		name, value, ok := strings.Cut(v, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --var %q (expected name=value)", v)
+		}
+		values[name] = value
+	}
+
+	missing := map[string]bool{}
+	var substitute func(n *yaml.Node)
+	substitute = func(n *yaml.Node) {
+		if n.Kind == yaml.ScalarNode {
+			n.Value = scaffoldVar.ReplaceAllStringFunc(n.Value, func(ref string) string {
+				name := scaffoldVar.FindStringSubmatch(ref)[1]
+				value, ok := values[name]
+				if !ok {
+					missing[name] = true
+					return ref
+				}
+				return value
+			})
+		}
+		for _, c := range n.Content {
+			substitute(c)
+		}
+	}
+	substitute(&root)
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("template uses undefined variable(s): %s (set them with --var name=value)", strings.Join(names, ", "))
+	}
+
+	// Decode strictly so misspelled keys are reported instead of ignored.
+	expanded, err := yaml.Marshal(&root)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(expanded))
+	dec.KnownFields(true)
+	var tmpl scaffoldTemplate
+	if err := dec.Decode(&tmpl); err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	return &tmpl, nil
+}
+
+func init() {
+	rootCmd.AddCommand(scaffoldCmd)
+}
@@ -0,0 +1,457 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// scaffolder applies a template to a space, creating only what does not
+// exist yet. Existing items are matched by name, case-insensitively.
+type scaffolder struct {
+	spaceID  string
+	dryRun   bool
+	statuses []api.Status // space statuses, for lists not created yet
+	users    map[string]int
+	created  int
+	existing int
+}
+
+// report prints one plan line: "+" for an item to create, "=" for one
+// that already exists.
+func (s *scaffolder) report(depth int, exists bool, kind, name, note string) {
+	mark := "+"
+	if exists {
+		mark = "="
+		s.existing++
+	} else {
+		s.created++
+	}
+	line := fmt.Sprintf("%s%s %s %q", strings.Repeat("  ", depth), mark, kind, name)
+	if note != "" {
+		line += "  (" + note + ")"
+	}
+	fmt.Println(line)
+}
+
+func (s *scaffolder) warn(depth int, format string, args ...interface{}) {
+	fmt.Printf("%s! %s\n", strings.Repeat("  ", depth), fmt.Sprintf(format, args...))
+}
+
+func (s *scaffolder) post(endpoint string, data, dest interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("encoding request: %w", err)
+	}
+	return client.Post(endpoint, bytes.NewReader(body), nil, dest)
+}
+
+func sameName(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+func (s *scaffolder) apply(tmpl *scaffoldTemplate) error {
+	if len(tmpl.Tags) > 0 {
+		if err := s.ensureTags(tmpl.Tags); err != nil {
+			return err
+		}
+	}
+
+	if len(tmpl.Folders) > 0 {
+		var resp api.FoldersResponse
+		if err := client.Get(fmt.Sprintf("/space/%s/folder", s.spaceID), nil, &resp); err != nil {
+			return fmt.Errorf("getting folders: %w", err)
+		}
+		for _, f := range tmpl.Folders {
+			var folder *api.Folder
+			for i := range resp.Folders {
+				if sameName(resp.Folders[i].Name, f.Name) {
+					folder = &resp.Folders[i]
+					break
+				}
+			}
+
+			if folder != nil {
+				s.report(0, true, "folder", f.Name, "")
+			} else {
+				s.report(0, false, "folder", f.Name, "")
+				folder = &api.Folder{Name: f.Name}
+				if !s.dryRun {
+					if err := s.post(fmt.Sprintf("/space/%s/folder", s.spaceID), map[string]string{"name": f.Name}, folder); err != nil {
+						return fmt.Errorf("creating folder %s: %w", f.Name, err)
+					}
+				}
+			}
+
+			for _, l := range f.Lists {
+				if err := s.ensureList(l, fmt.Sprintf("/folder/%s/list", folder.ID), folder.Lists, 1); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if len(tmpl.Lists) > 0 {
+		var resp api.ListsResponse
+		if err := client.Get(fmt.Sprintf("/space/%s/list", s.spaceID), nil, &resp); err != nil {
+			return fmt.Errorf("getting folderless lists: %w", err)
+		}
+		for _, l := range tmpl.Lists {
+			if err := s.ensureList(l, fmt.Sprintf("/space/%s/list", s.spaceID), resp.Lists, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *scaffolder) ensureTags(tags []scaffoldTag) error {
+	var resp api.TagsResponse
+	if err := client.Get(fmt.Sprintf("/space/%s/tag", s.spaceID), nil, &resp); err != nil {
+		return fmt.Errorf("getting tags: %w", err)
+	}
+
+	for _, t := range tags {
+		exists := false
+		for _, existing := range resp.Tags {
+			if sameName(existing.Name, t.Name) {
+				exists = true
+				break
+			}
+		}
+		s.report(0, exists, "tag", t.Name, "")
+		if exists || s.dryRun {
+			continue
+		}
+
+		tag := api.Tag{Name: t.Name, TagFg: api.Or(t.Fg, "#ffffff"), TagBg: api.Or(t.Bg, "#7b68ee")}
+		if err := s.post(fmt.Sprintf("/space/%s/tag", s.spaceID), map[string]api.Tag{"tag": tag}, nil); err != nil {
+			return fmt.Errorf("creating tag %s: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+// ensureList creates a list through endpoint unless one of existing has
+// its name, then its tasks.
+func (s *scaffolder) ensureList(l scaffoldList, endpoint string, existing []api.ListInfo, depth int) error {
+	var list *api.ListInfo
+	for i := range existing {
+		if sameName(existing[i].Name, l.Name) {
+			list = &existing[i]
+			break
+		}
+	}
+
+	var tasks []api.Task
+	statuses := s.statuses
+	if list != nil {
+		s.report(depth, true, "list", l.Name, "")
+		if len(l.Tasks) > 0 {
+			var info api.ListInfo
+			if err := client.Get(fmt.Sprintf("/list/%s", list.ID), nil, &info); err != nil {
+				return fmt.Errorf("getting list %s: %w", l.Name, err)
+			}
+			statuses = info.Statuses
+
+			params := map[string]string{"subtasks": "true", "include_closed": "true"}
+			var err error
+			if tasks, err = listTasksAll(fmt.Sprintf("/list/%s/task", list.ID), params); err != nil {
+				return fmt.Errorf("getting tasks in %s: %w", l.Name, err)
+			}
+		}
+	} else {
+		s.report(depth, false, "list", l.Name, "")
+		list = &api.ListInfo{Name: l.Name}
+		if !s.dryRun {
+			data := map[string]string{"name": l.Name}
+			if l.Description != "" {
+				data["content"] = l.Description
+			}
+			if err := s.post(endpoint, data, list); err != nil {
+				return fmt.Errorf("creating list %s: %w", l.Name, err)
+			}
+			if len(list.Statuses) > 0 {
+				statuses = list.Statuses
+			}
+		}
+	}
+
+	for _, t := range l.Tasks {
+		if err := s.ensureTask(t, list.ID, "", tasks, statuses, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureTask creates a task (a subtask if parentID is set) unless a
+// sibling in existing has its name, then its checklists and subtasks.
+func (s *scaffolder) ensureTask(t scaffoldTask, listID, parentID string, existing []api.Task, statuses []api.Status, depth int) error {
+	kind := "task"
+	if parentID != "" {
+		kind = "subtask"
+	}
+
+	var task *api.Task
+	for i := range existing {
+		parent := ""
+		if existing[i].Parent != nil {
+			parent = *existing[i].Parent
+		}
+		if parent == parentID && sameName(existing[i].Name, t.Name) {
+			task = &existing[i]
+			break
+		}
+	}
+
+	var checklists []api.Checklist
+	if task != nil {
+		s.report(depth, true, kind, t.Name, "")
+		if len(t.Checklists) > 0 {
+			var full api.Task
+			if err := client.Get(fmt.Sprintf("/task/%s", task.ID), nil, &full); err != nil {
+				return fmt.Errorf("getting task %s: %w", t.Name, err)
+			}
+			checklists = full.Checklists
+		}
+	} else {
+		data, note, err := s.taskRequest(t, parentID, statuses, depth)
+		if err != nil {
+			return err
+		}
+		s.report(depth, false, kind, t.Name, note)
+		task = &api.Task{Name: t.Name}
+		if !s.dryRun {
+			if err := s.post(fmt.Sprintf("/list/%s/task", listID), data, task); err != nil {
+				return fmt.Errorf("creating %s %s: %w", kind, t.Name, err)
+			}
+		}
+	}
+
+	if err := s.ensureChecklists(t.Checklists, task.ID, checklists, depth+1); err != nil {
+		return err
+	}
+	// In a dry run a new task has no ID; no existing task has the
+	// placeholder as parent, so its subtasks are all reported as new.
+	parent := task.ID
+	if parent == "" {
+		parent = "(new)"
+	}
+	for _, sub := range t.Subtasks {
+		if err := s.ensureTask(sub, listID, parent, existing, statuses, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// taskRequest builds the create request for a template task, validating
+// its status against the list's statuses. note summarises the settings.
+func (s *scaffolder) taskRequest(t scaffoldTask, parentID string, statuses []api.Status, depth int) (map[string]interface{}, string, error) {
+	data := map[string]interface{}{"name": t.Name}
+	var notes []string
+
+	if parentID != "" && parentID != "(new)" {
+		data["parent"] = parentID
+	}
+	if t.Description != "" {
+		data["markdown_description"] = t.Description
+	}
+	if t.Status != "" {
+		valid := len(statuses) == 0
+		for _, st := range statuses {
+			if sameName(st.Status, t.Status) {
+				valid = true
+				break
+			}
+		}
+		if valid {
+			data["status"] = t.Status
+			notes = append(notes, "status "+t.Status)
+		} else {
+			s.warn(depth, "status %q does not exist in this list; %q gets the default status", t.Status, t.Name)
+		}
+	}
+	if t.Priority != "" {
+		p, ok := priorities[strings.ToLower(t.Priority)]
+		if !ok {
+			return nil, "", fmt.Errorf("task %s: invalid priority %q (expected urgent, high, normal, low or none)", t.Name, t.Priority)
+		}
+		data["priority"] = p
+		notes = append(notes, t.Priority+" priority")
+	}
+	if t.Estimate != "" {
+		ms, err := api.ParseDuration(t.Estimate)
+		if err != nil {
+			return nil, "", fmt.Errorf("task %s: %w", t.Name, err)
+		}
+		data["time_estimate"] = ms
+		notes = append(notes, "est "+api.FormatDurationMs(ms))
+	}
+	if len(t.Assignees) > 0 {
+		ids := make([]int, len(t.Assignees))
+		for i, a := range t.Assignees {
+			id, ok := s.users[a]
+			if !ok {
+				resolved, err := resolveUserIDs([]string{a})
+				if err != nil {
+					return nil, "", fmt.Errorf("task %s: %w", t.Name, err)
+				}
+				id = resolved[0]
+				s.users[a] = id
+			}
+			ids[i] = id
+		}
+		data["assignees"] = ids
+		notes = append(notes, "assigned to "+strings.Join(t.Assignees, ", "))
+	}
+	if len(t.Tags) > 0 {
+		data["tags"] = t.Tags
+		notes = append(notes, "tags "+strings.Join(t.Tags, ", "))
+	}
+
+	return data, strings.Join(notes, "; "), nil
+}
+
+// ensureChecklists creates missing checklists on a task and missing items
+// on existing ones.
+func (s *scaffolder) ensureChecklists(cls []scaffoldChecklist, taskID string, existing []api.Checklist, depth int) error {
+	for _, cl := range cls {
+		var checklist *api.Checklist
+		for i := range existing {
+			if sameName(existing[i].Name, cl.Name) {
+				checklist = &existing[i]
+				break
+			}
+		}
+
+		var missing []string
+		if checklist != nil {
+			s.report(depth, true, "checklist", cl.Name, "")
+			for _, item := range cl.Items {
+				found := false
+				for _, ci := range checklist.Items {
+					if sameName(ci.Name, item) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					missing = append(missing, item)
+				}
+			}
+			for _, item := range missing {
+				s.report(depth+1, false, "item", item, "")
+			}
+		} else {
+			missing = cl.Items
+			s.report(depth, false, "checklist", cl.Name, fmt.Sprintf("%d item(s)", len(cl.Items)))
+			checklist = &api.Checklist{Name: cl.Name}
+			if !s.dryRun {
+				var resp api.ChecklistResponse
+				if err := s.post(fmt.Sprintf("/task/%s/checklist", taskID), map[string]string{"name": cl.Name}, &resp); err != nil {
+					return fmt.Errorf("creating checklist %s: %w", cl.Name, err)
+				}
+				checklist = &resp.Checklist
+			}
+		}
+
+		if s.dryRun {
+			continue
+		}
+		for _, item := range missing {
+			if err := s.post(fmt.Sprintf("/checklist/%s/checklist_item", checklist.ID), map[string]string{"name": item}, nil); err != nil {
+				return fmt.Errorf("adding checklist item %s: %w", item, err)
+			}
+		}
+	}
+	return nil
+}
+
+var scaffoldApplyCmd = &cobra.Command{
+	Use:   "apply <template.yaml>",
+	Short: "Create folders, lists and tasks from a template",
+	Long: `Create the folders, lists, tasks, subtasks, checklists and tags described
+in a YAML template in a space. Anything that already exists with the same
+name is left alone, so applying a template twice is safe and a failed run
+can simply be repeated. Use --dry-run to print the plan without changing
+anything: "+" marks what would be created, "=" what already exists.
+
+Strings may reference variables as {{ name }}, defined under "variables"
+in the template and overridden with --var name=value. A template looks
+like:
+
+  variables:
+    client: Acme
+  tags:
+    - name: onboarding
+  folders:
+    - name: "{{ client }}"
+      lists:
+        - name: Backlog
+          tasks:
+            - name: Kickoff with {{ client }}
+              status: to do
+              priority: high
+              estimate: 1h
+              assignees: [jane]
+              tags: [onboarding]
+              checklists:
+                - name: Prep
+                  items: [Agenda, Invite]
+              subtasks:
+                - name: Book a room
+  lists:            # folderless lists
+    - name: "{{ client }} Inbox"
+
+A task status that does not exist in its list is reported and the task
+gets the list's default status.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceID, _ := cmd.Flags().GetString("space")
+		vars, _ := cmd.Flags().GetStringArray("var")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		tmpl, err := loadScaffoldTemplate(args[0], vars)
+		if err != nil {
+			return err
+		}
+
+		var space api.Space
+		if err := client.Get(fmt.Sprintf("/space/%s", spaceID), nil, &space); err != nil {
+			return fmt.Errorf("getting space: %w", err)
+		}
+
+		if dryRun {
+			fmt.Printf("Plan for space %s (%s), dry run:\n\n", space.Name, space.ID)
+		} else {
+			fmt.Printf("Applying template to space %s (%s):\n\n", space.Name, space.ID)
+		}
+
+		s := &scaffolder{spaceID: spaceID, dryRun: dryRun, statuses: space.Statuses, users: map[string]int{}}
+		if err := s.apply(tmpl); err != nil {
+			return err
+		}
+
+		if dryRun {
+			fmt.Printf("\n%d item(s) to create, %d already exist\n", s.created, s.existing)
+		} else {
+			fmt.Printf("\nCreated %d item(s), %d already existed\n", s.created, s.existing)
+		}
+		return nil
+	},
+}
+
+func init() {
+	scaffoldCmd.AddCommand(scaffoldApplyCmd)
+	scaffoldApplyCmd.Flags().StringP("space", "S", "", "Space to apply the template to")
+	scaffoldApplyCmd.Flags().StringArray("var", nil, "Set a template variable, e.g. --var client=Acme (repeatable)")
+	scaffoldApplyCmd.Flags().BoolP("dry-run", "n", false, "Print the plan without creating anything")
+	_ = scaffoldApplyCmd.MarkFlagRequired("space")
+}
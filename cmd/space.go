@@ -7,7 +7,7 @@ import (
 var spaceCmd = &cobra.Command{
 	Use:   "space",
 	Short: "Manage ClickUp spaces",
-	Long:  `Search spaces and view their folder/list structure.`,
+	Long:  `Create, rename, delete and search spaces and view their folder/list structure.`,
 }
 
 func init() {
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var spaceCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a space",
+	Long:  `Create a space in the configured workspace.`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := strings.Join(args, " ")
+		multipleAssignees, _ := cmd.Flags().GetBool("multiple-assignees")
+
+		data := map[string]interface{}{
+			"name":               name,
+			"multiple_assignees": multipleAssignees,
+		}
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		var space api.Space
+		if err := client.Post(fmt.Sprintf("/team/%s/space", client.TeamID()), bytes.NewReader(body), nil, &space); err != nil {
+			return fmt.Errorf("creating space: %w", err)
+		}
+
+		fmt.Printf("Space created: %s (ID: %s)\n", space.Name, space.ID)
+		return nil
+	},
+}
+
+func init() {
+	spaceCmd.AddCommand(spaceCreateCmd)
+	spaceCreateCmd.Flags().Bool("multiple-assignees", true, "Allow multiple assignees on tasks")
+}
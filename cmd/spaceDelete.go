@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var spaceDeleteCmd = &cobra.Command{
+	Use:   "delete <space-id>",
+	Short: "Delete a space",
+	Long: `Permanently delete a space with all its folders, lists and tasks. Asks
+for confirmation unless --yes is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceID := args[0]
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		if !yes {
+			var space api.Space
+			if err := client.Get(fmt.Sprintf("/space/%s", spaceID), nil, &space); err != nil {
+				return fmt.Errorf("getting space: %w", err)
+			}
+			ok, err := confirm(fmt.Sprintf("Delete space %q (%s) with all its folders, lists and tasks?", space.Name, spaceID))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Aborted.")
+				return nil
+			}
+		}
+
+		if err := client.Delete(fmt.Sprintf("/space/%s", spaceID), nil, nil); err != nil {
+			return fmt.Errorf("deleting space: %w", err)
+		}
+
+		fmt.Printf("Space deleted: %s\n", spaceID)
+		return nil
+	},
+}
+
+func init() {
+	spaceCmd.AddCommand(spaceDeleteCmd)
+	spaceDeleteCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+}
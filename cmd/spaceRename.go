@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var spaceRenameCmd = &cobra.Command{
+	Use:   "rename <space-id> <name>",
+	Short: "Rename a space",
+	Long:  `Change the name of a space.`,
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceID := args[0]
+		name := strings.Join(args[1:], " ")
+
+		body, err := json.Marshal(map[string]string{"name": name})
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		if err := client.Put(fmt.Sprintf("/space/%s", spaceID), bytes.NewReader(body), nil, nil); err != nil {
+			return fmt.Errorf("renaming space: %w", err)
+		}
+
+		fmt.Printf("Space renamed: %s -> %s\n", spaceID, name)
+		return nil
+	},
+}
+
+func init() {
+	spaceCmd.AddCommand(spaceRenameCmd)
+}
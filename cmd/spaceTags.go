@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var spaceTagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "Manage the tags defined in a space",
+	Long:  `List, create, delete and rename the tags available to tasks in a space.`,
+}
+
+// findSpaceTag looks up a tag by name (case-insensitively, as ClickUp does).
+func findSpaceTag(spaceID, name string) (*api.Tag, error) {
+	var resp api.TagsResponse
+	if err := client.Get(fmt.Sprintf("/space/%s/tag", spaceID), nil, &resp); err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+	for _, t := range resp.Tags {
+		if strings.EqualFold(t.Name, name) {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("tag %q not found in space %s", name, spaceID)
+}
+
+func init() {
+	spaceCmd.AddCommand(spaceTagsCmd)
+}
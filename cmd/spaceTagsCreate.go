@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var spaceTagsCreateCmd = &cobra.Command{
+	Use:   "create <space-id> <name>",
+	Short: "Create a tag in a space",
+	Long:  `Create a new tag in a space. Colors are hex values such as #ffffff.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceID := args[0]
+		name := args[1]
+		fg, _ := cmd.Flags().GetString("fg")
+		bg, _ := cmd.Flags().GetString("bg")
+
+		data := map[string]api.Tag{
+			"tag": {Name: name, TagFg: fg, TagBg: bg},
+		}
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		if err := client.Post(fmt.Sprintf("/space/%s/tag", spaceID), bytes.NewReader(body), nil, nil); err != nil {
+			return fmt.Errorf("creating tag: %w", err)
+		}
+
+		fmt.Printf("Tag created: %s\n", api.FormatTag(data["tag"]))
+		return nil
+	},
+}
+
+func init() {
+	spaceTagsCmd.AddCommand(spaceTagsCreateCmd)
+	spaceTagsCreateCmd.Flags().String("fg", "#ffffff", "Foreground (text) color")
+	spaceTagsCreateCmd.Flags().String("bg", "#7b68ee", "Background color")
+}
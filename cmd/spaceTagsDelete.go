@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+)
+
+var spaceTagsDeleteCmd = &cobra.Command{
+	Use:   "delete <space-id> <name>",
+	Short: "Delete a tag from a space",
+	Long:  `Delete a tag from a space. The tag is also removed from every task that has it.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceID := args[0]
+		name := args[1]
+
+		if err := client.Delete(fmt.Sprintf("/space/%s/tag/%s", spaceID, url.PathEscape(name)), nil, nil); err != nil {
+			return fmt.Errorf("deleting tag: %w", err)
+		}
+
+		fmt.Printf("Tag deleted: %s\n", name)
+		return nil
+	},
+}
+
+func init() {
+	spaceTagsCmd.AddCommand(spaceTagsDeleteCmd)
+}
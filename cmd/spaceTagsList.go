@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var spaceTagsListCmd = &cobra.Command{
+	Use:   "list <space-id>",
+	Short: "List the tags in a space",
+	Long:  `List all tags defined in a space with their foreground and background colors.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceID := args[0]
+
+		var resp api.TagsResponse
+		if err := client.Get(fmt.Sprintf("/space/%s/tag", spaceID), nil, &resp); err != nil {
+			return fmt.Errorf("getting tags: %w", err)
+		}
+
+		if len(resp.Tags) == 0 {
+			fmt.Println("No tags found in this space.")
+			return nil
+		}
+
+		fmt.Printf("Found %d tag(s):\n\n", len(resp.Tags))
+		for _, t := range resp.Tags {
+			fmt.Println(api.FormatTag(t))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	spaceTagsCmd.AddCommand(spaceTagsListCmd)
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var spaceTagsRenameCmd = &cobra.Command{
+	Use:   "rename <space-id> <name> <new-name>",
+	Short: "Rename or recolor a tag in a space",
+	Long: `Rename a tag in a space. The tag keeps its colors unless --fg or --bg
+is given. Pass the same name twice to only change colors.`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceID := args[0]
+		name := args[1]
+		newName := args[2]
+		fg, _ := cmd.Flags().GetString("fg")
+		bg, _ := cmd.Flags().GetString("bg")
+
+		existing, err := findSpaceTag(spaceID, name)
+		if err != nil {
+			return err
+		}
+		if fg == "" {
+			fg = existing.TagFg
+		}
+		if bg == "" {
+			bg = existing.TagBg
+		}
+
+		// The update endpoint names the colors differently from the
+		// create and list endpoints.
+		data := map[string]map[string]string{
+			"tag": {"name": newName, "fg_color": fg, "bg_color": bg},
+		}
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		endpoint := fmt.Sprintf("/space/%s/tag/%s", spaceID, url.PathEscape(existing.Name))
+		if err := client.Put(endpoint, bytes.NewReader(body), nil, nil); err != nil {
+			return fmt.Errorf("updating tag: %w", err)
+		}
+
+		fmt.Printf("Tag updated: %s -> %s\n", existing.Name, api.FormatTag(api.Tag{Name: newName, TagFg: fg, TagBg: bg}))
+		return nil
+	},
+}
+
+func init() {
+	spaceTagsCmd.AddCommand(spaceTagsRenameCmd)
+	spaceTagsRenameCmd.Flags().String("fg", "", "New foreground (text) color")
+	spaceTagsRenameCmd.Flags().String("bg", "", "New background color")
+}
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
 	"github.com/spf13/cobra"
 )
 
@@ -10,6 +13,18 @@ var taskCmd = &cobra.Command{
 	Long:  `Search, view, update tasks and manage subtasks and relationships.`,
 }
 
+// internalTaskID looks up the internal ID of a task given by custom ID,
+// for the places where the API only accepts internal IDs (request bodies
+// and second task IDs in a path).
+func internalTaskID(customID string) (string, error) {
+	params := map[string]string{"custom_task_ids": "true", "team_id": client.TeamID()}
+	var task api.Task
+	if err := client.Get(fmt.Sprintf("/task/%s", customID), params, &task); err != nil {
+		return "", fmt.Errorf("resolving task %s: %w", customID, err)
+	}
+	return task.ID, nil
+}
+
 func init() {
 	rootCmd.AddCommand(taskCmd)
 }
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+var taskBulkCmd = &cobra.Command{
+	Use:   "bulk",
+	Short: "Apply an operation to many tasks at once",
+	Long: `Update, move, delete, assign or tag many tasks in one go.
+
+Target tasks are read from stdin (one ID per line, blank lines and lines
+starting with # are ignored) unless a filter flag is given, in which case
+the matching tasks from a team task search are used:
+
+  clickup-cli task search -l 901 -T wontfix -o ids | clickup-cli task bulk update -s closed
+  clickup-cli task bulk update --list 901 --tag wontfix -s closed
+
+Operations run concurrently (see --concurrency). A per-task report is
+printed at the end and the command exits non-zero if any task failed.`,
+}
+
+// bulkTargets resolves the task IDs a bulk command operates on. It reports
+// whether the IDs must be treated as custom task IDs.
+func bulkTargets(cmd *cobra.Command) ([]string, bool, error) {
+	var f taskFilter
+	f.ListID, _ = cmd.Flags().GetString("list")
+	f.SpaceID, _ = cmd.Flags().GetString("space")
+	f.Assignee, _ = cmd.Flags().GetString("assignee")
+	f.Status, _ = cmd.Flags().GetString("filter-status")
+	f.Tag, _ = cmd.Flags().GetString("tag")
+	f.Query, _ = cmd.Flags().GetString("query")
+
+	if !f.empty() {
+		tasks, err := searchTasks(f)
+		if err != nil {
+			return nil, false, fmt.Errorf("searching tasks: %w", err)
+		}
+		ids := make([]string, len(tasks))
+		for i, t := range tasks {
+			ids[i] = t.ID
+		}
+		return ids, false, nil
+	}
+
+	if stat, err := os.Stdin.Stat(); err == nil && stat.Mode()&os.ModeCharDevice != 0 {
+		return nil, false, fmt.Errorf("no tasks given: pipe task IDs on stdin or pass a filter flag")
+	}
+
+	custom, _ := cmd.Flags().GetBool("custom")
+	seen := map[string]bool{}
+	var ids []string
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || seen[line] {
+			continue
+		}
+		seen[line] = true
+		ids = append(ids, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, false, fmt.Errorf("reading task IDs from stdin: %w", err)
+	}
+	return ids, custom, nil
+}
+
+type bulkResult struct {
+	TaskID string
+	Err    error
+}
+
+// runBulk calls op for every task ID using at most concurrency workers,
+// printing a progress line to stderr when it is a terminal. Results are
+// returned in the order of ids.
+func runBulk(ids []string, concurrency int, op func(taskID string) error) []bulkResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	showProgress := false
+	if stat, err := os.Stderr.Stat(); err == nil && stat.Mode()&os.ModeCharDevice != 0 {
+		showProgress = true
+	}
+
+	results := make([]bulkResult, len(ids))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	done, failed := 0, 0
+
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				err := op(ids[i])
+				results[i] = bulkResult{TaskID: ids[i], Err: err}
+
+				mu.Lock()
+				done++
+				if err != nil {
+					failed++
+				}
+				if showProgress {
+					fmt.Fprintf(os.Stderr, "\r[%d/%d] %d ok, %d failed", done, len(ids), done-failed, failed)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i := range ids {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if showProgress && len(ids) > 0 {
+		fmt.Fprintln(os.Stderr)
+	}
+
+	return results
+}
+
+// reportBulk prints the per-task outcome of a bulk run and returns an error
+// if any task failed.
+func reportBulk(cmd *cobra.Command, action string, results []bulkResult) error {
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("  FAILED  %s: %v\n", r.TaskID, r.Err)
+		} else {
+			fmt.Printf("  ok      %s\n", r.TaskID)
+		}
+	}
+
+	fmt.Printf("\n%s %d of %d task(s)", action, len(results)-failed, len(results))
+	if failed > 0 {
+		fmt.Printf(", %d failed", failed)
+	}
+	fmt.Println()
+
+	if failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d task(s) failed", failed, len(results))
+	}
+	return nil
+}
+
+func init() {
+	taskCmd.AddCommand(taskBulkCmd)
+	taskBulkCmd.PersistentFlags().BoolP("custom", "c", false, "Treat task IDs read from stdin as custom task IDs")
+	taskBulkCmd.PersistentFlags().IntP("concurrency", "j", 4, "Number of tasks to process in parallel")
+	taskBulkCmd.PersistentFlags().StringP("list", "l", "", "Select tasks in this list ID instead of reading stdin")
+	taskBulkCmd.PersistentFlags().StringP("space", "S", "", "Select tasks in this space ID instead of reading stdin")
+	taskBulkCmd.PersistentFlags().String("assignee", "", "Select tasks assigned to this user ID instead of reading stdin")
+	taskBulkCmd.PersistentFlags().String("filter-status", "", "Select tasks with this status instead of reading stdin")
+	taskBulkCmd.PersistentFlags().StringP("tag", "T", "", "Select tasks with this tag instead of reading stdin")
+	taskBulkCmd.PersistentFlags().StringP("query", "q", "", "Select tasks whose name or description contains this text")
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// parseUserIDs splits a comma-separated list of numeric user IDs.
+func parseUserIDs(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var ids []int
+	for _, part := range strings.Split(s, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid user ID %q", part)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+var taskBulkAssignCmd = &cobra.Command{
+	Use:   "assign",
+	Short: "Add or remove assignees on many tasks",
+	Long:  `Add and/or remove assignees (numeric user IDs, comma-separated) on every selected task.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		addStr, _ := cmd.Flags().GetString("add")
+		removeStr, _ := cmd.Flags().GetString("remove")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		add, err := parseUserIDs(addStr)
+		if err != nil {
+			return err
+		}
+		remove, err := parseUserIDs(removeStr)
+		if err != nil {
+			return err
+		}
+		if len(add) == 0 && len(remove) == 0 {
+			return fmt.Errorf("at least one of --add or --remove must be provided")
+		}
+
+		data := map[string]interface{}{
+			"assignees": map[string][]int{
+				"add": append([]int{}, add...),
+				"rem": append([]int{}, remove...),
+			},
+		}
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		ids, custom, err := bulkTargets(cmd)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			fmt.Println("No tasks to assign.")
+			return nil
+		}
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		results := runBulk(ids, concurrency, func(taskID string) error {
+			return client.Put(fmt.Sprintf("/task/%s", taskID), bytes.NewReader(body), params, nil)
+		})
+		return reportBulk(cmd, "Updated assignees on", results)
+	},
+}
+
+func init() {
+	taskBulkCmd.AddCommand(taskBulkAssignCmd)
+	taskBulkAssignCmd.Flags().String("add", "", "User IDs to assign (comma-separated)")
+	taskBulkAssignCmd.Flags().String("remove", "", "User IDs to unassign (comma-separated)")
+}
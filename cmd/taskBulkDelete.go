@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var taskBulkDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete many tasks",
+	Long: `Permanently delete every selected task.
+
+Since stdin may carry the task IDs, there is no interactive prompt;
+--yes is required to confirm the deletion.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		yes, _ := cmd.Flags().GetBool("yes")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		ids, custom, err := bulkTargets(cmd)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			fmt.Println("No tasks to delete.")
+			return nil
+		}
+		if !yes {
+			return fmt.Errorf("refusing to delete %d task(s) without --yes", len(ids))
+		}
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		results := runBulk(ids, concurrency, func(taskID string) error {
+			return client.Delete(fmt.Sprintf("/task/%s", taskID), params, nil)
+		})
+		return reportBulk(cmd, "Deleted", results)
+	},
+}
+
+func init() {
+	taskBulkCmd.AddCommand(taskBulkDeleteCmd)
+	taskBulkDeleteCmd.Flags().BoolP("yes", "y", false, "Confirm deletion")
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskBulkMoveCmd = &cobra.Command{
+	Use:   "move",
+	Short: "Move many tasks to another list",
+	Long: `Change the home list of every selected task.
+
+Moving uses the v3 API, which only accepts internal task IDs; custom IDs
+are resolved first.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		listID, _ := cmd.Flags().GetString("to")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		ids, custom, err := bulkTargets(cmd)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			fmt.Println("No tasks to move.")
+			return nil
+		}
+
+		results := runBulk(ids, concurrency, func(taskID string) error {
+			if custom {
+				params := map[string]string{
+					"custom_task_ids": "true",
+					"team_id":         client.TeamID(),
+				}
+				var task api.Task
+				if err := client.Get(fmt.Sprintf("/task/%s", taskID), params, &task); err != nil {
+					return fmt.Errorf("resolving custom ID: %w", err)
+				}
+				taskID = task.ID
+			}
+			return client.V3().Put(fmt.Sprintf("/workspaces/%s/tasks/%s/home_list/%s", client.TeamID(), taskID, listID), nil, nil, nil)
+		})
+		return reportBulk(cmd, "Moved", results)
+	},
+}
+
+func init() {
+	taskBulkCmd.AddCommand(taskBulkMoveCmd)
+	taskBulkMoveCmd.Flags().String("to", "", "Destination list ID")
+	taskBulkMoveCmd.MarkFlagRequired("to")
+}
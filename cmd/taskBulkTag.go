@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var taskBulkTagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Add or remove tags on many tasks",
+	Long: `Add and/or remove tags (comma-separated names) on every selected task.
+Tags must already exist in the task's space.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		add, _ := cmd.Flags().GetStringSlice("add")
+		remove, _ := cmd.Flags().GetStringSlice("remove")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		if len(add) == 0 && len(remove) == 0 {
+			return fmt.Errorf("at least one of --add or --remove must be provided")
+		}
+
+		ids, custom, err := bulkTargets(cmd)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			fmt.Println("No tasks to tag.")
+			return nil
+		}
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		results := runBulk(ids, concurrency, func(taskID string) error {
+			for _, tag := range add {
+				endpoint := fmt.Sprintf("/task/%s/tag/%s", taskID, url.PathEscape(strings.TrimSpace(tag)))
+				if err := client.Post(endpoint, nil, params, nil); err != nil {
+					return fmt.Errorf("adding tag %q: %w", tag, err)
+				}
+			}
+			for _, tag := range remove {
+				endpoint := fmt.Sprintf("/task/%s/tag/%s", taskID, url.PathEscape(strings.TrimSpace(tag)))
+				if err := client.Delete(endpoint, params, nil); err != nil {
+					return fmt.Errorf("removing tag %q: %w", tag, err)
+				}
+			}
+			return nil
+		})
+		return reportBulk(cmd, "Tagged", results)
+	},
+}
+
+func init() {
+	taskBulkCmd.AddCommand(taskBulkTagCmd)
+	taskBulkTagCmd.Flags().StringSlice("add", nil, "Tags to add (comma-separated)")
+	taskBulkTagCmd.Flags().StringSlice("remove", nil, "Tags to remove (comma-separated)")
+}
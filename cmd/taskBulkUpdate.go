@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// priorities maps priority names to the numeric values the API expects.
+var priorities = map[string]interface{}{
+	"urgent": 1,
+	"high":   2,
+	"normal": 3,
+	"low":    4,
+	"none":   nil,
+}
+
+var taskBulkUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update status, priority or description of many tasks",
+	Long:  `Set the same status, priority and/or description on every selected task.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		status, _ := cmd.Flags().GetString("status")
+		priority, _ := cmd.Flags().GetString("priority")
+		description, _ := cmd.Flags().GetString("description")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+		if status == "" && priority == "" && description == "" {
+			return fmt.Errorf("at least one of --status, --priority, or --description must be provided")
+		}
+
+		data := map[string]interface{}{}
+		if status != "" {
+			data["status"] = status
+		}
+		if priority != "" {
+			p, ok := priorities[priority]
+			if !ok {
+				return fmt.Errorf("invalid priority %q (expected urgent, high, normal, low or none)", priority)
+			}
+			data["priority"] = p
+		}
+		if description != "" {
+			data["markdown_description"] = description
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		ids, custom, err := bulkTargets(cmd)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			fmt.Println("No tasks to update.")
+			return nil
+		}
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		results := runBulk(ids, concurrency, func(taskID string) error {
+			return client.Put(fmt.Sprintf("/task/%s", taskID), bytes.NewReader(body), params, nil)
+		})
+		return reportBulk(cmd, "Updated", results)
+	},
+}
+
+func init() {
+	taskBulkCmd.AddCommand(taskBulkUpdateCmd)
+	taskBulkUpdateCmd.Flags().StringP("status", "s", "", "New task status")
+	taskBulkUpdateCmd.Flags().StringP("priority", "p", "", "New priority: urgent, high, normal, low or none")
+	taskBulkUpdateCmd.Flags().StringP("description", "d", "", "New task description (Markdown)")
+}
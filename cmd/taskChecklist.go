@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var taskChecklistCmd = &cobra.Command{
+	Use:   "checklist",
+	Short: "Manage checklists on a task",
+	Long: `Create, rename and delete task checklists and manage their items.
+Checklist and item IDs are shown by "task get".`,
+}
+
+func init() {
+	taskCmd.AddCommand(taskChecklistCmd)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskChecklistCreateCmd = &cobra.Command{
+	Use:   "create <task-id> <name>",
+	Short: "Create a checklist on a task",
+	Long:  `Create a new, empty checklist on a task.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		custom, _ := cmd.Flags().GetBool("custom")
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		body, err := json.Marshal(map[string]string{"name": args[1]})
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		var resp api.ChecklistResponse
+		if err := client.Post(fmt.Sprintf("/task/%s/checklist", taskID), bytes.NewReader(body), params, &resp); err != nil {
+			return fmt.Errorf("creating checklist: %w", err)
+		}
+
+		fmt.Printf("Checklist created: %s (ID: %s)\n", resp.Checklist.Name, resp.Checklist.ID)
+		return nil
+	},
+}
+
+func init() {
+	taskChecklistCmd.AddCommand(taskChecklistCreateCmd)
+	taskChecklistCreateCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var taskChecklistDeleteCmd = &cobra.Command{
+	Use:   "delete <checklist-id>",
+	Short: "Delete a checklist",
+	Long:  `Delete a checklist and all of its items.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checklistID := args[0]
+
+		if err := client.Delete(fmt.Sprintf("/checklist/%s", checklistID), nil, nil); err != nil {
+			return fmt.Errorf("deleting checklist: %w", err)
+		}
+
+		fmt.Printf("Checklist deleted: %s\n", checklistID)
+		return nil
+	},
+}
+
+func init() {
+	taskChecklistCmd.AddCommand(taskChecklistDeleteCmd)
+}
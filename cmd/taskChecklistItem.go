@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskChecklistItemCmd = &cobra.Command{
+	Use:   "item",
+	Short: "Manage checklist items",
+	Long:  `Add, check, uncheck, assign and delete items on a checklist.`,
+}
+
+// updateChecklistItem applies data to a checklist item and returns the
+// updated checklist.
+func updateChecklistItem(checklistID, itemID string, data map[string]interface{}) (*api.Checklist, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("encoding request: %w", err)
+	}
+
+	var resp api.ChecklistResponse
+	endpoint := fmt.Sprintf("/checklist/%s/checklist_item/%s", checklistID, itemID)
+	if err := client.Put(endpoint, bytes.NewReader(body), nil, &resp); err != nil {
+		return nil, fmt.Errorf("updating checklist item: %w", err)
+	}
+	return &resp.Checklist, nil
+}
+
+func init() {
+	taskChecklistCmd.AddCommand(taskChecklistItemCmd)
+}
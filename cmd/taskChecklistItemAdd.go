@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskChecklistItemAddCmd = &cobra.Command{
+	Use:   "add <checklist-id> <name>",
+	Short: "Add an item to a checklist",
+	Long:  `Add an item to a checklist, optionally assigning it (user ID, username or email).`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checklistID := args[0]
+		assignee, _ := cmd.Flags().GetString("assignee")
+
+		data := map[string]interface{}{"name": args[1]}
+		if assignee != "" {
+			ids, err := resolveUserIDs([]string{assignee})
+			if err != nil {
+				return err
+			}
+			data["assignee"] = ids[0]
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		var resp api.ChecklistResponse
+		if err := client.Post(fmt.Sprintf("/checklist/%s/checklist_item", checklistID), bytes.NewReader(body), nil, &resp); err != nil {
+			return fmt.Errorf("adding checklist item: %w", err)
+		}
+
+		fmt.Print(api.FormatChecklist(resp.Checklist))
+		return nil
+	},
+}
+
+func init() {
+	taskChecklistItemCmd.AddCommand(taskChecklistItemAddCmd)
+	taskChecklistItemAddCmd.Flags().StringP("assignee", "a", "", "Assign the item to this user")
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskChecklistItemAssignCmd = &cobra.Command{
+	Use:   "assign <checklist-id> <item-id> <user>",
+	Short: "Assign a checklist item",
+	Long: `Assign a checklist item to a user (user ID, username or email).
+Use "none" as the user to unassign the item.`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var assignee interface{}
+		if args[2] != "none" {
+			ids, err := resolveUserIDs([]string{args[2]})
+			if err != nil {
+				return err
+			}
+			assignee = ids[0]
+		}
+
+		checklist, err := updateChecklistItem(args[0], args[1], map[string]interface{}{"assignee": assignee})
+		if err != nil {
+			return err
+		}
+
+		fmt.Print(api.FormatChecklist(*checklist))
+		return nil
+	},
+}
+
+func init() {
+	taskChecklistItemCmd.AddCommand(taskChecklistItemAssignCmd)
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var taskChecklistItemDeleteCmd = &cobra.Command{
+	Use:   "delete <checklist-id> <item-id>",
+	Short: "Delete a checklist item",
+	Long:  `Delete an item (and its nested items) from a checklist.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		endpoint := fmt.Sprintf("/checklist/%s/checklist_item/%s", args[0], args[1])
+		if err := client.Delete(endpoint, nil, nil); err != nil {
+			return fmt.Errorf("deleting checklist item: %w", err)
+		}
+
+		fmt.Printf("Checklist item deleted: %s\n", args[1])
+		return nil
+	},
+}
+
+func init() {
+	taskChecklistItemCmd.AddCommand(taskChecklistItemDeleteCmd)
+}
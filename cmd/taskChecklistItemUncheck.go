@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskChecklistItemUncheckCmd = &cobra.Command{
+	Use:   "uncheck <checklist-id> <item-id>",
+	Short: "Mark a checklist item as not done",
+	Long:  `Mark a checklist item as not done and show the checklist's progress.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checklist, err := updateChecklistItem(args[0], args[1], map[string]interface{}{"resolved": false})
+		if err != nil {
+			return err
+		}
+
+		fmt.Print(api.FormatChecklist(*checklist))
+		return nil
+	},
+}
+
+func init() {
+	taskChecklistItemCmd.AddCommand(taskChecklistItemUncheckCmd)
+}
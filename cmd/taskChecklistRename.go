@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var taskChecklistRenameCmd = &cobra.Command{
+	Use:   "rename <checklist-id> <name>",
+	Short: "Rename a checklist",
+	Long:  `Change the name of a checklist.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checklistID := args[0]
+
+		body, err := json.Marshal(map[string]string{"name": args[1]})
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		if err := client.Put(fmt.Sprintf("/checklist/%s", checklistID), bytes.NewReader(body), nil, nil); err != nil {
+			return fmt.Errorf("renaming checklist: %w", err)
+		}
+
+		fmt.Printf("Checklist renamed: %s\n", args[1])
+		return nil
+	},
+}
+
+func init() {
+	taskChecklistCmd.AddCommand(taskChecklistRenameCmd)
+}
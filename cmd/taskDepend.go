@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var taskDependCmd = &cobra.Command{
+	Use:   "depend",
+	Short: "Manage task dependencies",
+	Long: `Add or remove "waiting on" / "blocking" dependencies between tasks.
+
+  task depend add A --on B       A is waiting on B
+  task depend add A --blocks B   A is blocking B`,
+}
+
+// dependencyTarget reads --on/--blocks and returns the API field naming
+// the relationship together with the other task's ID.
+func dependencyTarget(cmd *cobra.Command) (string, string, error) {
+	on, _ := cmd.Flags().GetString("on")
+	blocks, _ := cmd.Flags().GetString("blocks")
+
+	switch {
+	case on != "" && blocks != "":
+		return "", "", fmt.Errorf("only one of --on or --blocks may be given")
+	case on != "":
+		return "depends_on", on, nil
+	case blocks != "":
+		return "dependency_of", blocks, nil
+	}
+	return "", "", fmt.Errorf("one of --on or --blocks is required")
+}
+
+func init() {
+	taskCmd.AddCommand(taskDependCmd)
+}
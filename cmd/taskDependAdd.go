@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var taskDependAddCmd = &cobra.Command{
+	Use:   "add <task-id>",
+	Short: "Make a task wait on or block another task",
+	Long:  `Add a dependency: --on makes the task wait on another, --blocks makes it block another.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		custom, _ := cmd.Flags().GetBool("custom")
+
+		field, other, err := dependencyTarget(cmd)
+		if err != nil {
+			return err
+		}
+
+		otherID := other
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+			if otherID, err = internalTaskID(other); err != nil {
+				return err
+			}
+		}
+
+		body, err := json.Marshal(map[string]string{field: otherID})
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		if err := client.Post(fmt.Sprintf("/task/%s/dependency", taskID), bytes.NewReader(body), params, nil); err != nil {
+			return fmt.Errorf("adding dependency: %w", err)
+		}
+
+		if field == "depends_on" {
+			fmt.Printf("Task %s is now waiting on %s\n", taskID, other)
+		} else {
+			fmt.Printf("Task %s is now blocking %s\n", taskID, other)
+		}
+		return nil
+	},
+}
+
+func init() {
+	taskDependCmd.AddCommand(taskDependAddCmd)
+	taskDependAddCmd.Flags().BoolP("custom", "c", false, "Treat both task IDs as custom task IDs")
+	taskDependAddCmd.Flags().String("on", "", "Task ID this task is waiting on")
+	taskDependAddCmd.Flags().String("blocks", "", "Task ID this task is blocking")
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var taskDependRemoveCmd = &cobra.Command{
+	Use:   "remove <task-id>",
+	Short: "Remove a dependency between two tasks",
+	Long:  `Remove a dependency previously added with --on or --blocks.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		custom, _ := cmd.Flags().GetBool("custom")
+
+		field, other, err := dependencyTarget(cmd)
+		if err != nil {
+			return err
+		}
+
+		otherID := other
+		if custom {
+			if otherID, err = internalTaskID(other); err != nil {
+				return err
+			}
+		}
+
+		params := map[string]string{field: otherID}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		if err := client.Delete(fmt.Sprintf("/task/%s/dependency", taskID), params, nil); err != nil {
+			return fmt.Errorf("removing dependency: %w", err)
+		}
+
+		fmt.Printf("Dependency removed between %s and %s\n", taskID, other)
+		return nil
+	},
+}
+
+func init() {
+	taskDependCmd.AddCommand(taskDependRemoveCmd)
+	taskDependRemoveCmd.Flags().BoolP("custom", "c", false, "Treat both task IDs as custom task IDs")
+	taskDependRemoveCmd.Flags().String("on", "", "Task ID this task is waiting on")
+	taskDependRemoveCmd.Flags().String("blocks", "", "Task ID this task is blocking")
+}
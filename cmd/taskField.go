@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskFieldCmd = &cobra.Command{
+	Use:   "field",
+	Short: "Set or clear custom field values on a task",
+	Long: `Set or clear a task's custom field values. Fields are referenced by name
+(case-insensitive) or ID; see "list fields" for what a list offers.`,
+}
+
+// findTaskField looks up one of a task's custom fields by ID or name.
+func findTaskField(task api.Task, name string) (*api.CustomField, error) {
+	for i, f := range task.CustomFields {
+		if f.ID == name || strings.EqualFold(f.Name, name) {
+			return &task.CustomFields[i], nil
+		}
+	}
+	return nil, fmt.Errorf("task %s has no custom field %q", task.ID, name)
+}
+
+func init() {
+	taskCmd.AddCommand(taskFieldCmd)
+}
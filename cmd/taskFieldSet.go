@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// fieldRequest converts human input into the request body for setting a
+// custom field, based on the field's type and (for users and task
+// relationships) its current value.
+func fieldRequest(f *api.CustomField, values []string) (map[string]interface{}, error) {
+	joined := strings.Join(values, " ")
+
+	switch f.Type {
+	case "drop_down":
+		for _, o := range f.TypeConfig.Options {
+			if o.ID == joined || strings.EqualFold(o.DisplayName(), joined) {
+				return map[string]interface{}{"value": o.ID}, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not an option of %s (options: %s)", joined, f.Name, optionNames(f))
+
+	case "labels":
+		ids := make([]string, 0, len(values))
+		for _, v := range values {
+			found := false
+			for _, o := range f.TypeConfig.Options {
+				if o.ID == v || strings.EqualFold(o.DisplayName(), v) {
+					ids = append(ids, o.ID)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("%q is not a label of %s (labels: %s)", v, f.Name, optionNames(f))
+			}
+		}
+		return map[string]interface{}{"value": ids}, nil
+
+	case "number", "currency", "emoji":
+		n, err := strconv.ParseFloat(joined, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s expects a number, got %q", f.Name, joined)
+		}
+		return map[string]interface{}{"value": n}, nil
+
+	case "date":
+		t, hasTime, err := api.ParseTime(joined, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"value":         t.UnixMilli(),
+			"value_options": map[string]bool{"time": hasTime},
+		}, nil
+
+	case "checkbox":
+		b, err := strconv.ParseBool(joined)
+		if err != nil {
+			return nil, fmt.Errorf("%s expects true or false, got %q", f.Name, joined)
+		}
+		return map[string]interface{}{"value": b}, nil
+
+	case "users":
+		ids, err := resolveUserIDs(values)
+		if err != nil {
+			return nil, err
+		}
+		var current []api.User
+		_ = json.Unmarshal(f.Value, &current)
+		rem := []int{}
+		for _, u := range current {
+			keep := false
+			for _, id := range ids {
+				keep = keep || u.ID == id
+			}
+			if !keep {
+				rem = append(rem, u.ID)
+			}
+		}
+		return map[string]interface{}{"value": map[string][]int{"add": ids, "rem": rem}}, nil
+
+	case "tasks", "list_relationship":
+		var current []struct {
+			ID string `json:"id"`
+		}
+		_ = json.Unmarshal(f.Value, &current)
+		rem := []string{}
+		for _, t := range current {
+			keep := false
+			for _, v := range values {
+				keep = keep || t.ID == v
+			}
+			if !keep {
+				rem = append(rem, t.ID)
+			}
+		}
+		return map[string]interface{}{"value": map[string][]string{"add": values, "rem": rem}}, nil
+
+	case "formula", "rollup", "automatic_progress":
+		return nil, fmt.Errorf("%s is a computed %s field and cannot be set", f.Name, f.Type)
+	}
+
+	return map[string]interface{}{"value": joined}, nil
+}
+
+func optionNames(f *api.CustomField) string {
+	names := make([]string, len(f.TypeConfig.Options))
+	for i, o := range f.TypeConfig.Options {
+		names[i] = o.DisplayName()
+	}
+	return strings.Join(names, ", ")
+}
+
+var taskFieldSetCmd = &cobra.Command{
+	Use:   "set <task-id> <field-name> <value>...",
+	Short: "Set a custom field value on a task",
+	Long: `Set a custom field on a task, converting the value according to the field type:
+
+  drop_down          option name
+  labels             one or more label names
+  number, currency   a number
+  date               YYYY-MM-DD [HH:MM], today, tomorrow or yesterday
+  checkbox           true or false
+  users              user IDs, usernames or emails (replaces the current users)
+  tasks              task IDs (replaces the current related tasks)
+  text and others    the remaining arguments joined by spaces`,
+	Args: cobra.MinimumNArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		custom, _ := cmd.Flags().GetBool("custom")
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		var task api.Task
+		if err := client.Get(fmt.Sprintf("/task/%s", taskID), params, &task); err != nil {
+			return fmt.Errorf("getting task: %w", err)
+		}
+
+		field, err := findTaskField(task, args[1])
+		if err != nil {
+			return err
+		}
+
+		data, err := fieldRequest(field, args[2:])
+		if err != nil {
+			return err
+		}
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		if err := client.Post(fmt.Sprintf("/task/%s/field/%s", task.ID, field.ID), bytes.NewReader(body), nil, nil); err != nil {
+			return fmt.Errorf("setting field: %w", err)
+		}
+
+		fmt.Printf("Set %s on task %s: %s\n", field.Name, taskID, strings.Join(args[2:], " "))
+		return nil
+	},
+}
+
+func init() {
+	taskFieldCmd.AddCommand(taskFieldSetCmd)
+	taskFieldSetCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskFieldUnsetCmd = &cobra.Command{
+	Use:   "unset <task-id> <field-name>",
+	Short: "Clear a custom field value on a task",
+	Long:  `Remove the value of a custom field from a task.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		custom, _ := cmd.Flags().GetBool("custom")
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		var task api.Task
+		if err := client.Get(fmt.Sprintf("/task/%s", taskID), params, &task); err != nil {
+			return fmt.Errorf("getting task: %w", err)
+		}
+
+		field, err := findTaskField(task, args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := client.Delete(fmt.Sprintf("/task/%s/field/%s", task.ID, field.ID), nil, nil); err != nil {
+			return fmt.Errorf("clearing field: %w", err)
+		}
+
+		fmt.Printf("Cleared %s on task %s\n", field.Name, taskID)
+		return nil
+	},
+}
+
+func init() {
+	taskFieldCmd.AddCommand(taskFieldUnsetCmd)
+	taskFieldUnsetCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+}
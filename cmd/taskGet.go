@@ -20,7 +20,7 @@ when the --custom flag is set.`,
 		custom, _ := cmd.Flags().GetBool("custom")
 		subtasks, _ := cmd.Flags().GetBool("subtasks")
 
-		params := map[string]string{}
+		params := map[string]string{"include_markdown_description": "true"}
 		if custom {
 			params["custom_task_ids"] = "true"
 			params["team_id"] = client.TeamID()
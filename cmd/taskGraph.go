@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskGraphCmd = &cobra.Command{
+	Use:   "graph [task-id]",
+	Short: "Export the dependency graph around tasks",
+	Long: `Walk dependencies, linked tasks, parents and subtasks outward from a task
+(or from every task matching the filter flags) and print the resulting graph.
+
+Formats:
+  tree     ASCII tree (default)
+  dot      Graphviz DOT, e.g. clickup-cli task graph MA-1 -c -f dot | dot -Tsvg > deps.svg
+  mermaid  Mermaid flowchart
+
+Tasks that block each other in a cycle are flagged, and the longest chain of
+open tasks blocking one another (the critical path) is highlighted.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		custom, _ := cmd.Flags().GetBool("custom")
+		depth, _ := cmd.Flags().GetInt("depth")
+		format, _ := cmd.Flags().GetString("format")
+
+		var f taskFilter
+		f.ListID, _ = cmd.Flags().GetString("list")
+		f.SpaceID, _ = cmd.Flags().GetString("space")
+		f.Assignee, _ = cmd.Flags().GetString("assignee")
+		f.Status, _ = cmd.Flags().GetString("status")
+		f.Tag, _ = cmd.Flags().GetString("tag")
+		f.Query, _ = cmd.Flags().GetString("query")
+		f.Subtasks = true
+
+		if format != "tree" && format != "dot" && format != "mermaid" {
+			return fmt.Errorf("invalid --format %q (expected tree, dot or mermaid)", format)
+		}
+		if (len(args) == 0) == f.empty() {
+			return fmt.Errorf("give either a task ID or filter flags")
+		}
+
+		fetchParams := map[string]string{"include_subtasks": "true"}
+
+		var roots []api.Task
+		if len(args) > 0 {
+			params := map[string]string{"include_subtasks": "true"}
+			if custom {
+				params["custom_task_ids"] = "true"
+				params["team_id"] = client.TeamID()
+			}
+			var task api.Task
+			if err := client.Get(fmt.Sprintf("/task/%s", args[0]), params, &task); err != nil {
+				return fmt.Errorf("getting task: %w", err)
+			}
+			roots = []api.Task{task}
+		} else {
+			tasks, err := searchTasks(f)
+			if err != nil {
+				return fmt.Errorf("searching tasks: %w", err)
+			}
+			if len(tasks) == 0 {
+				fmt.Println("No tasks found matching your criteria.")
+				return nil
+			}
+			roots = tasks
+		}
+
+		g := api.NewTaskGraph()
+		visited := map[string]bool{}
+		var rootIDs, frontier []string
+		for _, t := range roots {
+			g.AddTask(t)
+			rootIDs = append(rootIDs, t.ID)
+		}
+		for _, t := range roots {
+			visited[t.ID] = true
+			frontier = append(frontier, g.AddRelations(t)...)
+		}
+
+		var failed []string
+		for level := 1; level <= depth && len(frontier) > 0; level++ {
+			var ids []string
+			for _, id := range frontier {
+				if !visited[id] {
+					visited[id] = true
+					ids = append(ids, id)
+				}
+			}
+
+			tasks, errs := fetchTasks(ids, fetchParams)
+			for id, err := range errs {
+				failed = append(failed, fmt.Sprintf("%s: %v", id, err))
+			}
+
+			frontier = nil
+			for _, id := range ids {
+				if t, ok := tasks[id]; ok {
+					g.AddTask(t)
+					frontier = append(frontier, g.AddRelations(t)...)
+				}
+			}
+		}
+
+		switch format {
+		case "dot":
+			fmt.Print(api.FormatGraphDOT(g))
+		case "mermaid":
+			fmt.Print(api.FormatGraphMermaid(g))
+		default:
+			fmt.Print(api.FormatGraphTree(g, rootIDs))
+		}
+
+		if len(failed) > 0 {
+			return fmt.Errorf("could not fetch %d related task(s):\n  %s", len(failed), strings.Join(failed, "\n  "))
+		}
+		return nil
+	},
+}
+
+func init() {
+	taskCmd.AddCommand(taskGraphCmd)
+	taskGraphCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+	taskGraphCmd.Flags().IntP("depth", "d", 2, "How many relationship hops to follow")
+	taskGraphCmd.Flags().StringP("format", "f", "tree", "Output format: tree, dot or mermaid")
+	taskGraphCmd.Flags().StringP("list", "l", "", "Start from all tasks in this list ID")
+	taskGraphCmd.Flags().StringP("space", "S", "", "Start from tasks in this space ID")
+	taskGraphCmd.Flags().StringP("assignee", "a", "", "Start from tasks assigned to this user ID")
+	taskGraphCmd.Flags().StringP("status", "s", "", "Start from tasks with this status")
+	taskGraphCmd.Flags().StringP("tag", "T", "", "Start from tasks with this tag")
+	taskGraphCmd.Flags().StringP("query", "q", "", "Start from tasks whose name or description contains this text")
+}
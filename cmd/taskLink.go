@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var taskLinkCmd = &cobra.Command{
+	Use:   "link",
+	Short: "Manage linked tasks",
+	Long:  `Link or unlink two tasks. Links are undirected.`,
+}
+
+func init() {
+	taskCmd.AddCommand(taskLinkCmd)
+}
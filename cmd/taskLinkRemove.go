@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var taskLinkRemoveCmd = &cobra.Command{
+	Use:   "remove <task-id> <other-task-id>",
+	Short: "Remove the link between two tasks",
+	Long:  `Remove the link between two tasks.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		otherID := args[1]
+		custom, _ := cmd.Flags().GetBool("custom")
+
+		target := otherID
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+			var err error
+			if target, err = internalTaskID(otherID); err != nil {
+				return err
+			}
+		}
+
+		if err := client.Delete(fmt.Sprintf("/task/%s/link/%s", taskID, target), params, nil); err != nil {
+			return fmt.Errorf("unlinking tasks: %w", err)
+		}
+
+		fmt.Printf("Unlinked %s and %s\n", taskID, otherID)
+		return nil
+	},
+}
+
+func init() {
+	taskLinkCmd.AddCommand(taskLinkRemoveCmd)
+	taskLinkRemoveCmd.Flags().BoolP("custom", "c", false, "Treat both task IDs as custom task IDs")
+}
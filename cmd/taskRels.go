@@ -2,16 +2,74 @@ package cmd
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/otard95/clickup-cli/internal/api"
 	"github.com/spf13/cobra"
 )
 
+// fetchConcurrency bounds the number of parallel task lookups.
+const fetchConcurrency = 8
+
+// fetchTasks fetches tasks by internal ID in parallel. Tasks that could not
+// be fetched are reported in the error map instead.
+func fetchTasks(ids []string, params map[string]string) (map[string]api.Task, map[string]error) {
+	tasks := map[string]api.Task{}
+	errs := map[string]error{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, fetchConcurrency)
+	seen := map[string]bool{}
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var task api.Task
+			err := client.Get(fmt.Sprintf("/task/%s", id), params, &task)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[id] = err
+			} else {
+				tasks[id] = task
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	return tasks, errs
+}
+
+// formatRelatedTask renders one related task line, falling back to the
+// bare ID if it could not be fetched.
+func formatRelatedTask(id string, tasks map[string]api.Task, errs map[string]error) string {
+	t, ok := tasks[id]
+	if !ok {
+		return fmt.Sprintf("%s  (unavailable: %v)", id, errs[id])
+	}
+	label := t.ID
+	if t.CustomID != nil && *t.CustomID != "" {
+		label = fmt.Sprintf("%s (%s)", *t.CustomID, t.ID)
+	}
+	return fmt.Sprintf("%s  %s  [%s]", label, t.Name, t.Status.Status)
+}
+
 var taskRelsCmd = &cobra.Command{
 	Use:   "rels <task-id>",
 	Short: "Get task relationships (dependencies and linked tasks)",
-	Long:  `Show dependencies and linked tasks for a given task.`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Show dependencies and linked tasks for a given task. Related tasks are
+looked up to show their name, status and custom ID, and dependencies are
+split into the tasks this one is waiting on and the tasks it is blocking.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		taskID := args[0]
 		custom, _ := cmd.Flags().GetBool("custom")
@@ -32,22 +90,42 @@ var taskRelsCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("Relationships for: %s (%s)\n\n", task.Name, taskID)
-
-		if len(task.Dependencies) > 0 {
-			fmt.Printf("Dependencies (%d):\n", len(task.Dependencies))
-			for _, d := range task.Dependencies {
-				fmt.Printf("  - Task %s (type: %d, created: %s)\n",
-					d.DependsOn, d.Type, api.FormatTimestamp(d.DateCreated))
+		var waitingOn, blocking, linked, all []string
+		for _, d := range task.Dependencies {
+			other, isWaiting := d.Other(task.ID)
+			if isWaiting {
+				waitingOn = append(waitingOn, other)
+			} else {
+				blocking = append(blocking, other)
 			}
-			fmt.Println()
+			all = append(all, other)
+		}
+		for _, l := range task.LinkedTasks {
+			other := l.Other(task.ID)
+			linked = append(linked, other)
+			all = append(all, other)
 		}
 
-		if len(task.LinkedTasks) > 0 {
-			fmt.Printf("Linked Tasks (%d):\n", len(task.LinkedTasks))
-			for _, l := range task.LinkedTasks {
-				fmt.Printf("  - Task %s (created: %s, by user: %s)\n",
-					l.LinkID, api.FormatTimestamp(l.DateCreated), l.UserID)
+		// Related tasks are always referenced by internal ID.
+		related, errs := fetchTasks(all, nil)
+
+		fmt.Printf("Relationships for: %s (%s)\n\n", task.Name, taskID)
+
+		sections := []struct {
+			title string
+			ids   []string
+		}{
+			{"Waiting on", waitingOn},
+			{"Blocking", blocking},
+			{"Linked Tasks", linked},
+		}
+		for _, s := range sections {
+			if len(s.ids) == 0 {
+				continue
+			}
+			fmt.Printf("%s (%d):\n", s.title, len(s.ids))
+			for _, id := range s.ids {
+				fmt.Printf("  - %s\n", formatRelatedTask(id, related, errs))
 			}
 			fmt.Println()
 		}
@@ -2,62 +2,132 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/otard95/clickup-cli/internal/api"
 	"github.com/spf13/cobra"
 )
 
+// taskFilter holds the search criteria shared by task search and the bulk
+// commands.
+type taskFilter struct {
+	ListID   string
+	SpaceID  string
+	Assignee string
+	Status   string
+	Tag      string
+	Query    string
+	Subtasks bool
+	Closed   bool
+}
+
+// empty reports whether no filter criteria are set.
+func (f taskFilter) empty() bool {
+	f.Subtasks = false
+	f.Closed = false
+	return f == taskFilter{}
+}
+
+// listTasksAll fetches every page of a paginated task endpoint, such as
+// /list/{id}/task or /team/{id}/task.
+func listTasksAll(endpoint string, params map[string]string) ([]api.Task, error) {
+	var tasks []api.Task
+	for page := 0; ; page++ {
+		params["page"] = strconv.Itoa(page)
+
+		var resp api.TasksResponse
+		if err := client.Get(endpoint, params, &resp); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, resp.Tasks...)
+		if resp.LastPage || len(resp.Tasks) == 0 {
+			return tasks, nil
+		}
+	}
+}
+
+// searchTasks fetches every page of the team task search matching f. The
+// query is applied client-side to task names and descriptions.
+func searchTasks(f taskFilter) ([]api.Task, error) {
+	params := map[string]string{}
+	if f.ListID != "" {
+		params["list_ids[]"] = f.ListID
+	}
+	if f.SpaceID != "" {
+		params["space_ids[]"] = f.SpaceID
+	}
+	if f.Assignee != "" {
+		params["assignees[]"] = f.Assignee
+	}
+	if f.Status != "" {
+		params["statuses[]"] = f.Status
+	}
+	if f.Tag != "" {
+		params["tags[]"] = f.Tag
+	}
+	if f.Subtasks {
+		params["subtasks"] = "true"
+	}
+	if f.Closed {
+		params["include_closed"] = "true"
+	}
+
+	tasks, err := listTasksAll(fmt.Sprintf("/team/%s/task", client.TeamID()), params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Client-side text filter
+	if f.Query != "" {
+		queryLower := strings.ToLower(f.Query)
+		var filtered []api.Task
+		for _, t := range tasks {
+			if strings.Contains(strings.ToLower(t.Name), queryLower) ||
+				strings.Contains(strings.ToLower(t.Description), queryLower) {
+				filtered = append(filtered, t)
+			}
+		}
+		tasks = filtered
+	}
+
+	return tasks, nil
+}
+
 var taskSearchCmd = &cobra.Command{
 	Use:   "search [query]",
 	Short: "Search for tasks across workspaces",
 	Long: `Search for tasks with optional filters. The query argument performs
 client-side text filtering on task names and descriptions.
 
+Use --output ids to print one task ID per line, e.g. to pipe into
+"task bulk" commands.
+
 Note: The assignee flag requires a numeric user ID, not a username.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		query := ""
-		if len(args) > 0 {
-			query = strings.Join(args, " ")
+		output, _ := cmd.Flags().GetString("output")
+		if output != "text" && output != "ids" {
+			return fmt.Errorf("invalid --output %q (expected text or ids)", output)
 		}
 
-		listID, _ := cmd.Flags().GetString("list")
-		spaceID, _ := cmd.Flags().GetString("space")
-		assignee, _ := cmd.Flags().GetString("assignee")
-		status, _ := cmd.Flags().GetString("status")
+		var f taskFilter
+		f.Query = strings.Join(args, " ")
+		f.ListID, _ = cmd.Flags().GetString("list")
+		f.SpaceID, _ = cmd.Flags().GetString("space")
+		f.Assignee, _ = cmd.Flags().GetString("assignee")
+		f.Status, _ = cmd.Flags().GetString("status")
+		f.Tag, _ = cmd.Flags().GetString("tag")
 
-		params := map[string]string{}
-		if listID != "" {
-			params["list_ids[]"] = listID
-		}
-		if spaceID != "" {
-			params["space_ids[]"] = spaceID
-		}
-		if assignee != "" {
-			params["assignees[]"] = assignee
-		}
-		if status != "" {
-			params["statuses[]"] = status
-		}
-
-		var resp api.TasksResponse
-		if err := client.Get(fmt.Sprintf("/team/%s/task", client.TeamID()), params, &resp); err != nil {
+		tasks, err := searchTasks(f)
+		if err != nil {
 			return fmt.Errorf("searching tasks: %w", err)
 		}
 
-		tasks := resp.Tasks
-
-		// Client-side text filter
-		if query != "" {
-			queryLower := strings.ToLower(query)
-			var filtered []api.Task
+		if output == "ids" {
 			for _, t := range tasks {
-				if strings.Contains(strings.ToLower(t.Name), queryLower) ||
-					strings.Contains(strings.ToLower(t.Description), queryLower) {
-					filtered = append(filtered, t)
-				}
+				fmt.Println(t.ID)
 			}
-			tasks = filtered
+			return nil
 		}
 
 		if len(tasks) == 0 {
@@ -88,4 +158,6 @@ func init() {
 	taskSearchCmd.Flags().StringP("space", "S", "", "Filter by space ID")
 	taskSearchCmd.Flags().StringP("assignee", "a", "", "Filter by assignee user ID (numeric)")
 	taskSearchCmd.Flags().StringP("status", "s", "", "Filter by status")
+	taskSearchCmd.Flags().StringP("tag", "T", "", "Filter by tag name")
+	taskSearchCmd.Flags().StringP("output", "o", "text", "Output format: text or ids")
 }
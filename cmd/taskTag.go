@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var taskTagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Add or remove tags on a task",
+	Long:  `Add tags to or remove tags from a single task. Tags must exist in the task's space.`,
+}
+
+func init() {
+	taskCmd.AddCommand(taskTagCmd)
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var taskTagAddCmd = &cobra.Command{
+	Use:   "add <task-id> <tag>...",
+	Short: "Add tags to a task",
+	Long:  `Add one or more existing space tags to a task.`,
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		tags := args[1:]
+		custom, _ := cmd.Flags().GetBool("custom")
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		for _, tag := range tags {
+			endpoint := fmt.Sprintf("/task/%s/tag/%s", taskID, url.PathEscape(tag))
+			if err := client.Post(endpoint, nil, params, nil); err != nil {
+				return fmt.Errorf("adding tag %q: %w", tag, err)
+			}
+		}
+
+		fmt.Printf("Added tag(s) to task %s: %s\n", taskID, strings.Join(tags, ", "))
+		return nil
+	},
+}
+
+func init() {
+	taskTagCmd.AddCommand(taskTagAddCmd)
+	taskTagAddCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+}
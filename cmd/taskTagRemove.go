@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var taskTagRemoveCmd = &cobra.Command{
+	Use:   "remove <task-id> <tag>...",
+	Short: "Remove tags from a task",
+	Long:  `Remove one or more tags from a task. The tags remain defined in the space.`,
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		tags := args[1:]
+		custom, _ := cmd.Flags().GetBool("custom")
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		for _, tag := range tags {
+			endpoint := fmt.Sprintf("/task/%s/tag/%s", taskID, url.PathEscape(tag))
+			if err := client.Delete(endpoint, params, nil); err != nil {
+				return fmt.Errorf("removing tag %q: %w", tag, err)
+			}
+		}
+
+		fmt.Printf("Removed tag(s) from task %s: %s\n", taskID, strings.Join(tags, ", "))
+		return nil
+	},
+}
+
+func init() {
+	taskTagCmd.AddCommand(taskTagRemoveCmd)
+	taskTagRemoveCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var taskTreeCmd = &cobra.Command{
+	Use:   "tree <task-id>",
+	Short: "Show a task's nested subtasks as a tree",
+	Long: `Recursively fetch a task's subtasks and render them as a tree with status,
+assignees, estimate and time spent. Parent tasks also show the rolled-up
+estimate and time spent of their whole branch and the percentage of closed
+subtasks.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		custom, _ := cmd.Flags().GetBool("custom")
+		depth, _ := cmd.Flags().GetInt("depth")
+
+		params := map[string]string{"include_subtasks": "true"}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		var task api.Task
+		if err := client.Get(fmt.Sprintf("/task/%s", taskID), params, &task); err != nil {
+			return fmt.Errorf("getting task: %w", err)
+		}
+
+		root := &api.TaskNode{Task: task}
+		level := []*api.TaskNode{root}
+		seen := map[string]bool{task.ID: true}
+
+		// Fetch one level of subtasks at a time so each level is fetched in
+		// parallel.
+		for d := 1; len(level) > 0 && (depth == 0 || d <= depth); d++ {
+			var ids []string
+			for _, n := range level {
+				for _, st := range n.Task.Subtasks {
+					if !seen[st.ID] {
+						seen[st.ID] = true
+						ids = append(ids, st.ID)
+					}
+				}
+			}
+
+			tasks, errs := fetchTasks(ids, map[string]string{"include_subtasks": "true"})
+			for id, err := range errs {
+				return fmt.Errorf("getting subtask %s: %w", id, err)
+			}
+
+			var next []*api.TaskNode
+			for _, n := range level {
+				for _, st := range n.Task.Subtasks {
+					full, ok := tasks[st.ID]
+					if !ok {
+						continue
+					}
+					child := &api.TaskNode{Task: full}
+					n.Children = append(n.Children, child)
+					next = append(next, child)
+				}
+			}
+			level = next
+		}
+
+		fmt.Print(api.FormatTaskTree(root))
+		return nil
+	},
+}
+
+func init() {
+	taskCmd.AddCommand(taskTreeCmd)
+	taskTreeCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+	taskTreeCmd.Flags().IntP("depth", "d", 0, "Maximum subtask depth to fetch (0 = unlimited)")
+}
@@ -6,8 +6,8 @@ import (
 
 var timeCmd = &cobra.Command{
 	Use:   "time",
-	Short: "View time tracking entries",
-	Long:  `Get time tracking entries for tasks or teams.`,
+	Short: "Track time and view time entries",
+	Long:  `Start and stop timers and get time tracking entries for tasks or teams.`,
 }
 
 func init() {
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var timeCurrentCmd = &cobra.Command{
+	Use:   "current",
+	Short: "Show the running timer",
+	Long:  `Show the task, elapsed time and description of the timer currently running for the authenticated user.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var resp api.TimeEntryResponse
+		if err := client.Get(fmt.Sprintf("/team/%s/time_entries/current", client.TeamID()), nil, &resp); err != nil {
+			return fmt.Errorf("getting running timer: %w", err)
+		}
+
+		if resp.Data == nil || resp.Data.ID == "" {
+			fmt.Println("No timer is running.")
+			return nil
+		}
+
+		fmt.Print(api.FormatTimeEntry(*resp.Data, time.Now()))
+		return nil
+	},
+}
+
+func init() {
+	timeCmd.AddCommand(timeCurrentCmd)
+}
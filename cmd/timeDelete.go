@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var timeDeleteCmd = &cobra.Command{
+	Use:   "delete <entry-id>",
+	Short: "Delete a time entry",
+	Long:  `Delete a time entry by its ID (shown by "time get").`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entryID := args[0]
+
+		if err := client.Delete(fmt.Sprintf("/team/%s/time_entries/%s", client.TeamID(), entryID), nil, nil); err != nil {
+			return fmt.Errorf("deleting time entry: %w", err)
+		}
+
+		fmt.Printf("Time entry deleted: %s\n", entryID)
+		return nil
+	},
+}
+
+func init() {
+	timeCmd.AddCommand(timeDeleteCmd)
+}
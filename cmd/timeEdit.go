@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var timeEditCmd = &cobra.Command{
+	Use:   "edit <entry-id>",
+	Short: "Edit a time entry",
+	Long: `Change the duration, start, description, billable flag or task of a time
+entry. Entry IDs are shown by "time get".`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entryID := args[0]
+		durationStr, _ := cmd.Flags().GetString("duration")
+		at, _ := cmd.Flags().GetString("at")
+		description, _ := cmd.Flags().GetString("description")
+		billable, _ := cmd.Flags().GetBool("billable")
+		taskID, _ := cmd.Flags().GetString("task")
+
+		data := map[string]interface{}{}
+		if cmd.Flags().Changed("description") {
+			data["description"] = description
+		}
+		if cmd.Flags().Changed("billable") {
+			data["billable"] = billable
+		}
+		if taskID != "" {
+			data["tid"] = taskID
+		}
+
+		if durationStr != "" || at != "" {
+			endpoint := fmt.Sprintf("/team/%s/time_entries/%s", client.TeamID(), entryID)
+			var resp api.TimeEntryResponse
+			if err := client.Get(endpoint, nil, &resp); err != nil {
+				return fmt.Errorf("getting time entry: %w", err)
+			}
+			if resp.Data == nil {
+				return fmt.Errorf("time entry %s not found", entryID)
+			}
+
+			// The API wants start, end and duration to agree, so fill in
+			// whichever the user did not change from the current entry.
+			startMs, _ := strconv.ParseInt(resp.Data.Start, 10, 64)
+			durationMs, _ := strconv.ParseInt(resp.Data.Duration, 10, 64)
+			if at != "" {
+				start, _, err := api.ParseTime(at, time.Now())
+				if err != nil {
+					return err
+				}
+				startMs = start.UnixMilli()
+			}
+			if durationStr != "" {
+				var err error
+				if durationMs, err = api.ParseDuration(durationStr); err != nil {
+					return err
+				}
+			}
+			data["start"] = startMs
+			data["end"] = startMs + durationMs
+			data["duration"] = durationMs
+		}
+
+		if len(data) == 0 {
+			return fmt.Errorf("at least one of --duration, --at, --description, --billable, or --task must be provided")
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		if err := client.Put(fmt.Sprintf("/team/%s/time_entries/%s", client.TeamID(), entryID), bytes.NewReader(body), nil, nil); err != nil {
+			return fmt.Errorf("updating time entry: %w", err)
+		}
+
+		fmt.Printf("Time entry updated: %s\n", entryID)
+		return nil
+	},
+}
+
+func init() {
+	timeCmd.AddCommand(timeEditCmd)
+	timeEditCmd.Flags().String("duration", "", "New duration, e.g. 1h30m")
+	timeEditCmd.Flags().String("at", "", "New start time, e.g. \"yesterday 09:00\"")
+	timeEditCmd.Flags().StringP("description", "d", "", "New description")
+	timeEditCmd.Flags().BoolP("billable", "b", false, "Mark the entry as billable (--billable=false to clear)")
+	timeEditCmd.Flags().String("task", "", "Move the entry to this task ID")
+}
@@ -3,34 +3,152 @@ package cmd
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/otard95/clickup-cli/internal/api"
 	"github.com/spf13/cobra"
 )
 
+// addTimeEntryFilterFlags registers the team time entry filters shared by
+// time get and time report.
+func addTimeEntryFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String("from", "", "Start of range: date, \"yesterday 09:00\", this-week, last-month, ...")
+	cmd.Flags().String("to", "", "End of range (inclusive when a plain date)")
+	cmd.Flags().StringSliceP("assignee", "a", nil, "Only entries by these users (ID, username or email; repeatable)")
+	cmd.Flags().StringP("space", "S", "", "Only entries in this space")
+	cmd.Flags().StringP("folder", "F", "", "Only entries in this folder")
+	cmd.Flags().StringP("list", "l", "", "Only entries in this list")
+	cmd.Flags().String("task", "", "Only entries on this task")
+	cmd.Flags().BoolP("custom", "c", false, "Treat the task ID (argument or --task) as a custom task ID")
+	cmd.Flags().StringP("team", "t", "", "Override team ID (defaults to CLICKUP_TEAM_ID)")
+}
+
+// timeEntryParams builds the query parameters for the team time entries
+// endpoint from the filter flags.
+func timeEntryParams(cmd *cobra.Command) (map[string]string, error) {
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	assignees, _ := cmd.Flags().GetStringSlice("assignee")
+	custom, _ := cmd.Flags().GetBool("custom")
+
+	params := map[string]string{}
+
+	start, end, err := api.ParseTimeRange(from, to, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !start.IsZero() {
+		params["start_date"] = strconv.FormatInt(start.UnixMilli(), 10)
+	}
+	if !end.IsZero() {
+		params["end_date"] = strconv.FormatInt(end.UnixMilli()-1, 10)
+	}
+
+	if len(assignees) > 0 {
+		ids, err := resolveUserIDs(assignees)
+		if err != nil {
+			return nil, err
+		}
+		strs := make([]string, len(ids))
+		for i, id := range ids {
+			strs[i] = strconv.Itoa(id)
+		}
+		params["assignee"] = strings.Join(strs, ",")
+	}
+
+	// The API accepts only one location filter at a time.
+	var location []string
+	for _, f := range []struct{ flag, param string }{
+		{"space", "space_id"},
+		{"folder", "folder_id"},
+		{"list", "list_id"},
+		{"task", "task_id"},
+	} {
+		if v, _ := cmd.Flags().GetString(f.flag); v != "" {
+			params[f.param] = v
+			location = append(location, "--"+f.flag)
+		}
+	}
+	if len(location) > 1 {
+		return nil, fmt.Errorf("only one of --space, --folder, --list or --task may be given (got %s)", strings.Join(location, ", "))
+	}
+	if custom {
+		params["custom_task_ids"] = "true"
+		params["team_id"] = client.TeamID()
+	}
+
+	return params, nil
+}
+
+// timeEntriesTeam returns the team ID selected by --team.
+func timeEntriesTeam(cmd *cobra.Command) string {
+	teamID, _ := cmd.Flags().GetString("team")
+	if teamID == "" {
+		teamID = client.TeamID()
+	}
+	return teamID
+}
+
+// entryLocation renders a time entry's space, folder and list names.
+func entryLocation(l *api.TaskLocation) string {
+	var parts []string
+	for _, p := range []string{l.SpaceName, l.FolderName, l.ListName} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, " / ")
+}
+
 var timeGetCmd = &cobra.Command{
 	Use:   "get [task-id]",
 	Short: "Get time tracking entries",
 	Long: `Get time tracking entries for a specific task or the whole team.
 
 If a task ID is given, shows time entries for that task.
-Otherwise, shows entries for the configured team.`,
+Otherwise, shows entries for the configured team. Without filters the API
+only returns the last 30 days for the token owner; use --from/--to,
+--assignee and the location filters to widen or narrow that, e.g.
+
+  clickup-cli time get --from last-month --assignee jane --list 901234
+
+Only workspace owners and admins can see other members' time.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		includeInfo, _ := cmd.Flags().GetBool("include-task-info")
+
 		var resp api.TimeEntriesResponse
 		var context string
 
 		if len(args) > 0 {
+			for _, f := range []string{"from", "to", "assignee", "space", "folder", "list", "task"} {
+				if cmd.Flags().Changed(f) {
+					return fmt.Errorf("--%s applies to team time entries; use --task instead of a task argument", f)
+				}
+			}
+
 			taskID := args[0]
-			if err := client.Get(fmt.Sprintf("/task/%s/time", taskID), nil, &resp); err != nil {
+			params := map[string]string{}
+			if custom, _ := cmd.Flags().GetBool("custom"); custom {
+				params["custom_task_ids"] = "true"
+				params["team_id"] = client.TeamID()
+			}
+			if err := client.Get(fmt.Sprintf("/task/%s/time", taskID), params, &resp); err != nil {
 				return fmt.Errorf("getting time entries: %w", err)
 			}
 			context = fmt.Sprintf("task %s", taskID)
 		} else {
-			teamID, _ := cmd.Flags().GetString("team")
-			if teamID == "" {
-				teamID = client.TeamID()
+			teamID := timeEntriesTeam(cmd)
+			params, err := timeEntryParams(cmd)
+			if err != nil {
+				return err
+			}
+			if includeInfo {
+				params["include_task_tags"] = "true"
+				params["include_location_names"] = "true"
 			}
-			if err := client.Get(fmt.Sprintf("/team/%s/time_entries", teamID), nil, &resp); err != nil {
+
+			if err := client.Get(fmt.Sprintf("/team/%s/time_entries", teamID), params, &resp); err != nil {
 				return fmt.Errorf("getting time entries: %w", err)
 			}
 			context = fmt.Sprintf("team %s", teamID)
@@ -41,15 +159,35 @@ Otherwise, shows entries for the configured team.`,
 			return nil
 		}
 
+		var total int64
 		fmt.Printf("Found %d time entry/entries for %s:\n\n", len(resp.Data), context)
 		for _, e := range resp.Data {
 			durationMs, _ := strconv.ParseInt(e.Duration, 10, 64)
+			if durationMs > 0 {
+				total += durationMs
+			}
 			fmt.Printf("  %s  %s  %s\n", api.FormatTimestamp(e.Start), api.FormatDurationMs(durationMs), e.User.Username)
+			if e.Task != nil && e.Task.ID != "" {
+				fmt.Printf("    Task: %s (%s)\n", e.Task.Name, e.Task.ID)
+			}
+			if e.TaskLocation != nil {
+				if loc := entryLocation(e.TaskLocation); loc != "" {
+					fmt.Printf("    In: %s\n", loc)
+				}
+			}
+			if len(e.TaskTags) > 0 {
+				tags := make([]string, len(e.TaskTags))
+				for i, t := range e.TaskTags {
+					tags[i] = t.Name
+				}
+				fmt.Printf("    Task tags: %s\n", strings.Join(tags, ", "))
+			}
 			if e.Description != "" {
 				fmt.Printf("    %s\n", e.Description)
 			}
 			fmt.Println()
 		}
+		fmt.Printf("Total: %s\n", api.FormatDurationMs(total))
 
 		return nil
 	},
@@ -57,5 +195,6 @@ Otherwise, shows entries for the configured team.`,
 
 func init() {
 	timeCmd.AddCommand(timeGetCmd)
-	timeGetCmd.Flags().StringP("team", "t", "", "Override team ID (defaults to CLICKUP_TEAM_ID)")
+	addTimeEntryFilterFlags(timeGetCmd)
+	timeGetCmd.Flags().BoolP("include-task-info", "i", false, "Include task tags and space/folder/list names")
 }
@@ -0,0 +1,451 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// importRow is one time entry read from an import file.
+type importRow struct {
+	source      string
+	start       time.Time
+	durationMs  int64
+	description string
+	tags        []string
+	billable    bool
+	// candidates are values that may identify the task: a task column,
+	// project name or tags, in order of preference.
+	candidates []string
+}
+
+// importColumns maps import fields to the CSV header names recognised by
+// default, covering common Toggl export columns.
+var importColumns = map[string][]string{
+	"task":        {"task", "task id", "task_id"},
+	"project":     {"project"},
+	"start":       {"start", "start date", "start_date"},
+	"start_time":  {"start time", "start_time"},
+	"end":         {"end", "end date", "end_date"},
+	"end_time":    {"end time", "end_time"},
+	"duration":    {"duration"},
+	"description": {"description", "annotation", "note"},
+	"tags":        {"tags", "tag"},
+	"billable":    {"billable"},
+}
+
+// readImportCSV parses CSV rows. mapping overrides the header used for a
+// field, as "field=Header".
+func readImportCSV(r io.Reader, mapping []string) ([]importRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV file is empty")
+	}
+
+	header := map[string]int{}
+	for i, h := range records[0] {
+		header[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+
+	columns := map[string]int{}
+	for field, names := range importColumns {
+		for _, n := range names {
+			if i, ok := header[n]; ok {
+				columns[field] = i
+				break
+			}
+		}
+	}
+	for _, m := range mapping {
+		field, name, ok := strings.Cut(m, "=")
+		if _, known := importColumns[field]; !ok || !known {
+			return nil, fmt.Errorf("invalid column mapping %q (expected field=Header, field one of task, project, start, start_time, end, end_time, duration, description, tags, billable)", m)
+		}
+		i, found := header[strings.ToLower(strings.TrimSpace(name))]
+		if !found {
+			return nil, fmt.Errorf("column %q not found in CSV header", name)
+		}
+		columns[field] = i
+	}
+	if _, ok := columns["start"]; !ok {
+		return nil, fmt.Errorf("no start column found; map one with --column start=<header>")
+	}
+	_, hasEnd := columns["end"]
+	if _, ok := columns["duration"]; !ok && !hasEnd {
+		return nil, fmt.Errorf("no duration or end column found; map one with --column duration=<header>")
+	}
+
+	now := time.Now()
+	var rows []importRow
+	for n, rec := range records[1:] {
+		get := func(field string) string {
+			if i, ok := columns[field]; ok && i < len(rec) {
+				return strings.TrimSpace(rec[i])
+			}
+			return ""
+		}
+		joinTime := func(date, clock string) string {
+			if clock == "" {
+				return date
+			}
+			return date + " " + clock
+		}
+		if strings.Join(rec, "") == "" {
+			continue
+		}
+
+		row := importRow{
+			source:      fmt.Sprintf("line %d", n+2),
+			description: get("description"),
+			candidates:  []string{get("task"), get("project")},
+		}
+		switch strings.ToLower(get("billable")) {
+		case "yes", "true", "1", "y":
+			row.billable = true
+		}
+		for _, t := range strings.Split(get("tags"), ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				row.tags = append(row.tags, t)
+			}
+		}
+		row.candidates = append(row.candidates, row.tags...)
+
+		row.start, _, err = api.ParseTime(joinTime(get("start"), get("start_time")), now)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", row.source, err)
+		}
+		if d := get("duration"); d != "" {
+			if row.durationMs, err = api.ParseDuration(d); err != nil {
+				return nil, fmt.Errorf("%s: %w", row.source, err)
+			}
+		} else {
+			end, _, err := api.ParseTime(joinTime(get("end"), get("end_time")), now)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", row.source, err)
+			}
+			row.durationMs = end.Sub(row.start).Milliseconds()
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// timewarriorInterval is one interval of a "timew export".
+type timewarriorInterval struct {
+	ID         int      `json:"id"`
+	Start      string   `json:"start"`
+	End        string   `json:"end"`
+	Tags       []string `json:"tags"`
+	Annotation string   `json:"annotation"`
+}
+
+// readImportTimewarrior parses the JSON written by "timew export". Open
+// intervals are skipped.
+func readImportTimewarrior(r io.Reader) ([]importRow, int, error) {
+	var intervals []timewarriorInterval
+	if err := json.NewDecoder(r).Decode(&intervals); err != nil {
+		return nil, 0, fmt.Errorf("reading timewarrior JSON: %w", err)
+	}
+
+	const layout = "20060102T150405Z"
+	var rows []importRow
+	open := 0
+	for i, iv := range intervals {
+		if iv.End == "" {
+			open++
+			continue
+		}
+		start, err := time.Parse(layout, iv.Start)
+		if err != nil {
+			return nil, 0, fmt.Errorf("interval %d: invalid start %q", i+1, iv.Start)
+		}
+		end, err := time.Parse(layout, iv.End)
+		if err != nil {
+			return nil, 0, fmt.Errorf("interval %d: invalid end %q", i+1, iv.End)
+		}
+		rows = append(rows, importRow{
+			source:      fmt.Sprintf("interval @%d", iv.ID),
+			start:       start.Local(),
+			durationMs:  end.Sub(start).Milliseconds(),
+			description: iv.Annotation,
+			tags:        iv.Tags,
+			candidates:  iv.Tags,
+		})
+	}
+	return rows, open, nil
+}
+
+var (
+	// nativeTaskID and customTaskID match values shaped like ClickUp task
+	// IDs ("86a1b2c3d") and custom task IDs ("ABC-123"). Only those are
+	// looked up, so plain project names and tags cost no requests.
+	nativeTaskID = regexp.MustCompile(`^[0-9a-z]{6,12}$`)
+	customTaskID = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*-\d+$`)
+)
+
+// taskResolver matches import values to tasks by task ID, custom task ID
+// or an explicit mapping, caching lookups.
+type taskResolver struct {
+	mapping map[string]string
+	cache   map[string]string
+}
+
+func (r *taskResolver) resolve(value string) (string, error) {
+	if mapped, ok := r.mapping[value]; ok {
+		value = mapped
+	}
+	var params map[string]string
+	switch {
+	case customTaskID.MatchString(value):
+		params = map[string]string{"custom_task_ids": "true", "team_id": client.TeamID()}
+	case !nativeTaskID.MatchString(value) || !strings.ContainsAny(value, "0123456789"):
+		return "", nil
+	}
+	if id, ok := r.cache[value]; ok {
+		return id, nil
+	}
+
+	// Only a not-found answer means the value is not a task; anything else
+	// (a bad token, rate limiting, the network) aborts the import.
+	var task api.Task
+	id := ""
+	err := client.Get(fmt.Sprintf("/task/%s", value), params, &task)
+	switch {
+	case err == nil:
+		id = task.ID
+	case !api.IsNotFound(err):
+		return "", fmt.Errorf("looking up task %s: %w", value, err)
+	}
+	r.cache[value] = id
+	return id, nil
+}
+
+// match returns the task for the first candidate that resolves, and the
+// candidate that matched it.
+func (r *taskResolver) match(row importRow) (taskID, matched string, err error) {
+	for _, c := range row.candidates {
+		id, err := r.resolve(strings.TrimSpace(c))
+		if err != nil {
+			return "", "", err
+		}
+		if id != "" {
+			return id, c, nil
+		}
+	}
+	return "", "", nil
+}
+
+// entryKey identifies a time entry for duplicate detection: the same task,
+// start minute and duration in minutes.
+func entryKey(taskID string, startMs, durationMs int64) string {
+	return fmt.Sprintf("%s/%d/%d", taskID, startMs/60000, (durationMs+30000)/60000)
+}
+
+var timeImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import time entries from CSV or timewarrior",
+	Long: `Create time entries from a CSV file (e.g. a Toggl export) or the JSON
+written by "timew export". The format is picked from the file extension
+unless --format is given.
+
+CSV columns are found by header name: task, project, start (or "start
+date" plus "start time"), end (or "end date" plus "end time"), duration,
+description, tags and billable. Map other headers with --column, e.g.
+--column task="Ticket" --column duration="Hours".
+
+Each row is matched to a task by trying its task column, project and tags
+in turn as a task ID or custom task ID; only values shaped like one
+(e.g. 86a1b2c3d or ABC-12) are looked up. Use --task-map to map other
+values, e.g. --task-map "Client X=ABC-12". Rows that match the same task,
+start minute and duration as an existing entry are skipped as duplicates.
+Use --dry-run to preview without creating anything.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := args[0]
+		format, _ := cmd.Flags().GetString("format")
+		columns, _ := cmd.Flags().GetStringArray("column")
+		taskMaps, _ := cmd.Flags().GetStringArray("task-map")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if format == "" {
+			format = "csv"
+			if strings.EqualFold(filepath.Ext(path), ".json") {
+				format = "timewarrior"
+			}
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("opening import file: %w", err)
+		}
+		defer f.Close()
+
+		var rows []importRow
+		switch format {
+		case "csv":
+			rows, err = readImportCSV(f, columns)
+		case "timewarrior":
+			var open int
+			rows, open, err = readImportTimewarrior(f)
+			if open > 0 {
+				fmt.Fprintf(os.Stderr, "Skipping %d open interval(s).\n", open)
+			}
+		default:
+			return fmt.Errorf("invalid --format %q (expected csv or timewarrior)", format)
+		}
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			fmt.Println("No time entries found in file.")
+			return nil
+		}
+
+		resolver := &taskResolver{mapping: map[string]string{}, cache: map[string]string{}}
+		for _, m := range taskMaps {
+			value, task, ok := strings.Cut(m, "=")
+			if !ok || task == "" {
+				return fmt.Errorf("invalid task mapping %q (expected value=task-id)", m)
+			}
+			resolver.mapping[value] = task
+		}
+
+		// Existing entries in the imported period, for duplicate detection.
+		first, last := rows[0].start, rows[0].start
+		for _, r := range rows {
+			if r.start.Before(first) {
+				first = r.start
+			}
+			if end := r.start.Add(time.Duration(r.durationMs) * time.Millisecond); end.After(last) {
+				last = end
+			}
+		}
+		params := map[string]string{
+			"start_date": strconv.FormatInt(first.UnixMilli(), 10),
+			"end_date":   strconv.FormatInt(last.UnixMilli(), 10),
+		}
+		var existing api.TimeEntriesResponse
+		if err := client.Get(fmt.Sprintf("/team/%s/time_entries", client.TeamID()), params, &existing); err != nil {
+			return fmt.Errorf("getting existing time entries: %w", err)
+		}
+		seen := map[string]bool{}
+		for _, e := range existing.Data {
+			if e.Task == nil {
+				continue
+			}
+			startMs, _ := strconv.ParseInt(e.Start, 10, 64)
+			durationMs, _ := strconv.ParseInt(e.Duration, 10, 64)
+			seen[entryKey(e.Task.ID, startMs, durationMs)] = true
+		}
+
+		var created, duplicates, unmatched, invalid, failed int
+		for _, r := range rows {
+			if r.durationMs <= 0 {
+				fmt.Printf("skip    %s: non-positive duration\n", r.source)
+				invalid++
+				continue
+			}
+
+			taskID, matched, err := resolver.match(r)
+			if err != nil {
+				return err
+			}
+			if taskID == "" {
+				var values []string
+				for _, c := range r.candidates {
+					if c != "" {
+						values = append(values, c)
+					}
+				}
+				fmt.Printf("skip    %s: no task matches %q\n", r.source, strings.Join(values, ", "))
+				unmatched++
+				continue
+			}
+
+			key := entryKey(taskID, r.start.UnixMilli(), r.durationMs)
+			if seen[key] {
+				fmt.Printf("skip    %s: duplicate of an existing entry\n", r.source)
+				duplicates++
+				continue
+			}
+			seen[key] = true
+
+			var tags []api.Tag
+			for _, t := range r.tags {
+				if t != matched {
+					tags = append(tags, api.Tag{Name: t})
+				}
+			}
+
+			summary := fmt.Sprintf("%s %s on %s", r.start.Format("2006-01-02 15:04"), api.FormatDurationMs(r.durationMs), taskID)
+			if dryRun {
+				fmt.Printf("create  %s: %s\n", r.source, summary)
+				created++
+				continue
+			}
+
+			data := map[string]interface{}{
+				"tid":      taskID,
+				"start":    r.start.UnixMilli(),
+				"duration": r.durationMs,
+				"billable": r.billable,
+			}
+			if r.description != "" {
+				data["description"] = r.description
+			}
+			if len(tags) > 0 {
+				data["tags"] = tags
+			}
+			body, err := json.Marshal(data)
+			if err != nil {
+				return fmt.Errorf("encoding request: %w", err)
+			}
+			if err := client.Post(fmt.Sprintf("/team/%s/time_entries", client.TeamID()), bytes.NewReader(body), nil, nil); err != nil {
+				fmt.Printf("error   %s: %v\n", r.source, err)
+				failed++
+				continue
+			}
+			fmt.Printf("created %s: %s\n", r.source, summary)
+			created++
+		}
+
+		verb := "Created"
+		if dryRun {
+			verb = "Would create"
+		}
+		fmt.Printf("\n%s %d of %d entries; skipped %d duplicate(s), %d unmatched, %d invalid",
+			verb, created, len(rows), duplicates, unmatched, invalid)
+		if failed > 0 {
+			fmt.Printf("; %d failed\n", failed)
+			cmd.SilenceUsage = true
+			return fmt.Errorf("%d of %d entries failed to import", failed, len(rows))
+		}
+		fmt.Println()
+
+		return nil
+	},
+}
+
+func init() {
+	timeCmd.AddCommand(timeImportCmd)
+	timeImportCmd.Flags().StringP("format", "f", "", "Input format: csv or timewarrior (default: by file extension)")
+	timeImportCmd.Flags().StringArray("column", nil, "Map a field to a CSV header, e.g. duration=Hours (repeatable)")
+	timeImportCmd.Flags().StringArray("task-map", nil, "Map a task, project or tag value to a task ID, e.g. \"Client X=ABC-12\" (repeatable)")
+	timeImportCmd.Flags().BoolP("dry-run", "n", false, "Show what would be imported without creating entries")
+}
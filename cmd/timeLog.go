@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var timeLogCmd = &cobra.Command{
+	Use:   "log <task-id> <duration>",
+	Short: "Log time spent on a task",
+	Long: `Create a time entry on a task. The duration accepts forms like 1h30m, 45m
+or 1.5h. By default the entry ends now; use --at to set when it started,
+e.g. --at "yesterday 09:00" or --at "2026-03-14 13:00".`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		custom, _ := cmd.Flags().GetBool("custom")
+		at, _ := cmd.Flags().GetString("at")
+		description, _ := cmd.Flags().GetString("description")
+		billable, _ := cmd.Flags().GetBool("billable")
+		tags, _ := cmd.Flags().GetStringSlice("tags")
+
+		durationMs, err := api.ParseDuration(args[1])
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		start := now.Add(-time.Duration(durationMs) * time.Millisecond)
+		if at != "" {
+			if start, _, err = api.ParseTime(at, now); err != nil {
+				return err
+			}
+		}
+
+		data := map[string]interface{}{
+			"tid":      taskID,
+			"start":    start.UnixMilli(),
+			"duration": durationMs,
+			"billable": billable,
+		}
+		if description != "" {
+			data["description"] = description
+		}
+		if len(tags) > 0 {
+			tagObjs := make([]api.Tag, len(tags))
+			for i, t := range tags {
+				tagObjs[i] = api.Tag{Name: t}
+			}
+			data["tags"] = tagObjs
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		var resp api.TimeEntryResponse
+		if err := client.Post(fmt.Sprintf("/team/%s/time_entries", client.TeamID()), bytes.NewReader(body), params, &resp); err != nil {
+			return fmt.Errorf("logging time: %w", err)
+		}
+
+		id := ""
+		if resp.Data != nil {
+			id = resp.Data.ID
+		}
+		fmt.Printf("Logged %s on task %s starting %s (ID: %s)\n",
+			api.FormatDurationMs(durationMs), taskID, start.Format("2006-01-02 15:04"), id)
+		return nil
+	},
+}
+
+func init() {
+	timeCmd.AddCommand(timeLogCmd)
+	timeLogCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+	timeLogCmd.Flags().String("at", "", "When the work started (default: duration ago)")
+	timeLogCmd.Flags().StringP("description", "d", "", "Description of the work")
+	timeLogCmd.Flags().BoolP("billable", "b", false, "Mark the time as billable")
+	timeLogCmd.Flags().StringSliceP("tags", "T", nil, "Time entry tags (comma-separated)")
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// msToHours renders a duration as decimal hours for machine-readable output.
+func msToHours(ms int64) string {
+	return strconv.FormatFloat(float64(ms)/float64(time.Hour/time.Millisecond), 'f', 2, 64)
+}
+
+var timeReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Summarize time entries with totals per group",
+	Long: `Aggregate team time entries into a timesheet. Entries are grouped by each
+--by key in turn (user, task, list, tag, task-tag, day or week), with
+subtotals per group, a grand total, and a billable/non-billable split.
+
+"tag" groups by time entry tags and "task-tag" by the tags of the task;
+an entry with several tags counts towards each of them, so tag subtotals
+can add up to more than the grand total.
+
+Output is a text table, CSV (one row per innermost group, hours as
+decimals) or JSON (the nested groups, durations in milliseconds). Entries
+are selected with the same filters as "time get", e.g.
+
+  clickup-cli time report --from last-month --by user,task -o csv`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		by, _ := cmd.Flags().GetStringSlice("by")
+		output, _ := cmd.Flags().GetString("output")
+		if output != "text" && output != "csv" && output != "json" {
+			return fmt.Errorf("invalid --output %q (expected text, csv or json)", output)
+		}
+
+		teamID := timeEntriesTeam(cmd)
+		params, err := timeEntryParams(cmd)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(by, "list") {
+			params["include_location_names"] = "true"
+		}
+		if slices.Contains(by, "task-tag") {
+			params["include_task_tags"] = "true"
+		}
+
+		var resp api.TimeEntriesResponse
+		if err := client.Get(fmt.Sprintf("/team/%s/time_entries", teamID), params, &resp); err != nil {
+			return fmt.Errorf("getting time entries: %w", err)
+		}
+
+		report, running, err := api.BuildTimeReport(resp.Data, by)
+		if err != nil {
+			return err
+		}
+		if running > 0 {
+			fmt.Fprintf(os.Stderr, "Skipped %d running timer(s).\n", running)
+		}
+
+		switch output {
+		case "json":
+			out := struct {
+				From    string         `json:"from,omitempty"`
+				To      string         `json:"to,omitempty"`
+				GroupBy []string       `json:"group_by"`
+				Report  *api.ReportRow `json:"report"`
+			}{params["start_date"], params["end_date"], by, report}
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(out); err != nil {
+				return fmt.Errorf("encoding report: %w", err)
+			}
+
+		case "csv":
+			w := csv.NewWriter(os.Stdout)
+			header := append(slices.Clone(by), "entries", "total_hours", "billable_hours", "non_billable_hours")
+			_ = w.Write(header)
+			if report.Entries > 0 {
+				paths, rows := api.ReportLeaves(report)
+				for i, r := range rows {
+					record := append(slices.Clone(paths[i]), strconv.Itoa(r.Entries), msToHours(r.TotalMs), msToHours(r.BillableMs), msToHours(r.NonBillableMs()))
+					_ = w.Write(record)
+				}
+			}
+			w.Flush()
+			if err := w.Error(); err != nil {
+				return fmt.Errorf("writing CSV: %w", err)
+			}
+
+		default:
+			if report.Entries == 0 {
+				fmt.Printf("No time entries found for team %s.\n", teamID)
+				return nil
+			}
+			fmt.Printf("Time report for team %s, by %s\n", teamID, strings.Join(by, ", "))
+			if params["start_date"] != "" || params["end_date"] != "" {
+				fmt.Printf("Period: %s - %s\n", api.Or(api.FormatTimestamp(params["start_date"]), "..."), api.Or(api.FormatTimestamp(params["end_date"]), "now"))
+			}
+			fmt.Println()
+			fmt.Print(api.FormatTimeReport(report))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	timeCmd.AddCommand(timeReportCmd)
+	addTimeEntryFilterFlags(timeReportCmd)
+	timeReportCmd.Flags().StringSliceP("by", "b", []string{"user"}, "Group by: user, task, list, tag, task-tag, day, week (comma-separated for nesting)")
+	timeReportCmd.Flags().StringP("output", "o", "text", "Output format: text, csv or json")
+}
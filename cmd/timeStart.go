@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var timeStartCmd = &cobra.Command{
+	Use:   "start <task-id>",
+	Short: "Start a timer on a task",
+	Long:  `Start tracking time on a task. Any timer already running is stopped by ClickUp.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskID := args[0]
+		custom, _ := cmd.Flags().GetBool("custom")
+		description, _ := cmd.Flags().GetString("description")
+		billable, _ := cmd.Flags().GetBool("billable")
+		tags, _ := cmd.Flags().GetStringSlice("tags")
+
+		data := map[string]interface{}{
+			"tid":      taskID,
+			"billable": billable,
+		}
+		if description != "" {
+			data["description"] = description
+		}
+		if len(tags) > 0 {
+			tagObjs := make([]api.Tag, len(tags))
+			for i, t := range tags {
+				tagObjs[i] = api.Tag{Name: t}
+			}
+			data["tags"] = tagObjs
+		}
+
+		body, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		params := map[string]string{}
+		if custom {
+			params["custom_task_ids"] = "true"
+			params["team_id"] = client.TeamID()
+		}
+
+		var resp api.TimeEntryResponse
+		if err := client.Post(fmt.Sprintf("/team/%s/time_entries/start", client.TeamID()), bytes.NewReader(body), params, &resp); err != nil {
+			return fmt.Errorf("starting timer: %w", err)
+		}
+
+		fmt.Println("Timer started:")
+		if resp.Data != nil {
+			fmt.Print(api.FormatTimeEntry(*resp.Data, time.Now()))
+		}
+		return nil
+	},
+}
+
+func init() {
+	timeCmd.AddCommand(timeStartCmd)
+	timeStartCmd.Flags().BoolP("custom", "c", false, "Treat the task ID as a custom task ID")
+	timeStartCmd.Flags().StringP("description", "d", "", "Description of the work")
+	timeStartCmd.Flags().BoolP("billable", "b", false, "Mark the time as billable")
+	timeStartCmd.Flags().StringSliceP("tags", "T", nil, "Time entry tags (comma-separated)")
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/otard95/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var timeStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the running timer",
+	Long:  `Stop the timer currently running for the authenticated user.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var resp api.TimeEntryResponse
+		if err := client.Post(fmt.Sprintf("/team/%s/time_entries/stop", client.TeamID()), nil, nil, &resp); err != nil {
+			return fmt.Errorf("stopping timer: %w", err)
+		}
+
+		fmt.Println("Timer stopped:")
+		if resp.Data != nil {
+			fmt.Print(api.FormatTimeEntry(*resp.Data, time.Now()))
+		}
+		return nil
+	},
+}
+
+func init() {
+	timeCmd.AddCommand(timeStopCmd)
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,11 +13,43 @@ import (
 	"github.com/otard95/clickup-cli/internal/config"
 )
 
-const baseURL = "https://api.clickup.com/api/v2"
+const (
+	baseURL   = "https://api.clickup.com/api/v2"
+	baseURLV3 = "https://api.clickup.com/api/v3"
+)
+
+// HTTPError is a non-2xx response from the API.
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is a response saying the requested item
+// does not exist. ClickUp answers some unknown IDs with another 4xx status
+// and a "not found" message, so the message is checked as well.
+func IsNotFound(err error) bool {
+	var e *HTTPError
+	if !errors.As(err, &e) {
+		return false
+	}
+	if e.StatusCode == http.StatusNotFound {
+		return true
+	}
+	var body struct {
+		Err string `json:"err"`
+	}
+	return e.StatusCode < 500 && json.Unmarshal([]byte(e.Body), &body) == nil &&
+		strings.Contains(strings.ToLower(body.Err), "not found")
+}
 
 type Client struct {
 	cfg  *config.Config
 	http *http.Client
+	base string
 }
 
 func NewClient(cfg *config.Config) *Client {
@@ -25,9 +58,16 @@ func NewClient(cfg *config.Config) *Client {
 		http: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		base: baseURL,
 	}
 }
 
+// V3 returns a client for the v3 API. It shares configuration and the
+// underlying HTTP client with c; only the base path differs.
+func (c *Client) V3() *Client {
+	return &Client{cfg: c.cfg, http: c.http, base: baseURLV3}
+}
+
 func (c *Client) TeamID() string {
 	return c.cfg.TeamID
 }
@@ -36,7 +76,7 @@ func (c *Client) TeamID() string {
 // params is a map of query parameters; values that are slices will be expanded
 // into repeated keys (e.g. "assignees[]" => ["1","2"]).
 func (c *Client) request(method, endpoint string, body io.Reader, params map[string]string, dest interface{}) error {
-	u, err := url.Parse(baseURL + endpoint)
+	u, err := url.Parse(c.base + endpoint)
 	if err != nil {
 		return fmt.Errorf("invalid endpoint: %w", err)
 	}
@@ -69,7 +109,7 @@ func (c *Client) request(method, endpoint string, body io.Reader, params map[str
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
+		return &HTTPError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	if dest != nil && len(respBody) > 0 {
@@ -93,6 +133,10 @@ func (c *Client) Post(endpoint string, body io.Reader, params map[string]string,
 	return c.request(http.MethodPost, endpoint, body, params, dest)
 }
 
+func (c *Client) Delete(endpoint string, params map[string]string, dest interface{}) error {
+	return c.request(http.MethodDelete, endpoint, nil, params, dest)
+}
+
 // SetQueryArray adds repeated query params to a URL (e.g. assignees[]=1&assignees[]=2).
 // This is a helper for building params maps for endpoints that need array params.
 func SetQueryArray(endpoint string, key string, values []string) string {
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WalkPages calls fn for every page in depth-first order, with its depth
+// below the top level.
+func WalkPages(pages []DocPage, fn func(p DocPage, depth int)) {
+	var walk func(pages []DocPage, depth int)
+	walk = func(pages []DocPage, depth int) {
+		for _, p := range pages {
+			fn(p, depth)
+			walk(p.Pages, depth+1)
+		}
+	}
+	walk(pages, 0)
+}
+
+// LivePages returns pages without the archived ones and their subpages.
+func LivePages(pages []DocPage) []DocPage {
+	var out []DocPage
+	for _, p := range pages {
+		if p.Archived {
+			continue
+		}
+		p.Pages = LivePages(p.Pages)
+		out = append(out, p)
+	}
+	return out
+}
+
+// FormatPageTree renders a document's page hierarchy using the same
+// box-drawing style as "space structure".
+func FormatPageTree(pages []DocPage) string {
+	var b strings.Builder
+
+	var walk func(pages []DocPage, prefix string)
+	walk = func(pages []DocPage, prefix string) {
+		for i, p := range pages {
+			branch, next := "├── ", "│   "
+			if i == len(pages)-1 {
+				branch, next = "└── ", "    "
+			}
+			archived := ""
+			if p.Archived {
+				archived = " [archived]"
+			}
+			fmt.Fprintf(&b, "%s%s%s (%s)%s\n", prefix, branch, Or(p.Name, "Untitled"), p.ID, archived)
+			walk(p.Pages, prefix+next)
+		}
+	}
+	walk(pages, "")
+
+	return b.String()
+}
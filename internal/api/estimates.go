@@ -0,0 +1,137 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// EstimateTotals sums time estimates and tracked time over a group of
+// tasks, e.g. one task, an assignee or a list.
+type EstimateTotals struct {
+	Key        string
+	Tasks      int
+	Overruns   int
+	EstimateMs int64
+	SpentMs    int64
+}
+
+// VarianceMs returns tracked minus estimated time; positive means overrun.
+func (t EstimateTotals) VarianceMs() int64 {
+	return t.SpentMs - t.EstimateMs
+}
+
+// VariancePct returns the variance as a percentage of the estimate, or NaN
+// if nothing was estimated.
+func (t EstimateTotals) VariancePct() float64 {
+	if t.EstimateMs == 0 {
+		return math.NaN()
+	}
+	return float64(t.VarianceMs()) / float64(t.EstimateMs) * 100
+}
+
+// TaskEstimate returns the estimate and tracked time of a task. ok is false
+// if the task has no estimate.
+func TaskEstimate(t Task) (estimateMs, spentMs int64, ok bool) {
+	if t.TimeSpent != nil {
+		spentMs = *t.TimeSpent
+	}
+	if t.TimeEstimate == nil || *t.TimeEstimate <= 0 {
+		return 0, spentMs, false
+	}
+	return *t.TimeEstimate, spentMs, true
+}
+
+// EstimateRollup accumulates estimate totals per key.
+type EstimateRollup struct {
+	groups map[string]*EstimateTotals
+}
+
+// Add counts a task's estimate and tracked time towards key.
+func (r *EstimateRollup) Add(key string, estimateMs, spentMs int64, overrun bool) {
+	if r.groups == nil {
+		r.groups = map[string]*EstimateTotals{}
+	}
+	g, ok := r.groups[key]
+	if !ok {
+		g = &EstimateTotals{Key: key}
+		r.groups[key] = g
+	}
+	g.Tasks++
+	g.EstimateMs += estimateMs
+	g.SpentMs += spentMs
+	if overrun {
+		g.Overruns++
+	}
+}
+
+// Sorted returns the groups ordered by variance percentage, worst first.
+func (r *EstimateRollup) Sorted() []EstimateTotals {
+	out := make([]EstimateTotals, 0, len(r.groups))
+	for _, g := range r.groups {
+		out = append(out, *g)
+	}
+	SortByVariance(out)
+	return out
+}
+
+// SortByVariance orders totals by variance percentage, worst first, with
+// unestimated totals last.
+func SortByVariance(totals []EstimateTotals) {
+	pct := func(t EstimateTotals) float64 {
+		if p := t.VariancePct(); !math.IsNaN(p) {
+			return p
+		}
+		return math.Inf(-1)
+	}
+	sort.SliceStable(totals, func(i, j int) bool {
+		pi, pj := pct(totals[i]), pct(totals[j])
+		if pi != pj {
+			return pi > pj
+		}
+		return totals[i].Key < totals[j].Key
+	})
+}
+
+// FormatVarianceMs renders a signed duration such as "+1h 30m" or "-45m".
+func FormatVarianceMs(ms int64) string {
+	if ms < 0 {
+		return "-" + FormatDurationMs(-ms)
+	}
+	return "+" + FormatDurationMs(ms)
+}
+
+// FormatVariancePct renders a variance percentage such as "+25%", or "-"
+// if it is undefined.
+func FormatVariancePct(pct float64) string {
+	if math.IsNaN(pct) {
+		return "-"
+	}
+	return fmt.Sprintf("%+.0f%%", pct)
+}
+
+// FormatEstimateTable renders estimate totals as a text table under the
+// given heading for the key column.
+func FormatEstimateTable(heading string, rows []EstimateTotals) string {
+	width := len(heading)
+	for _, r := range rows {
+		width = max(width, len([]rune(r.Key)))
+	}
+	width = min(width, 50)
+
+	var b strings.Builder
+	line := func(key string, cols ...string) {
+		if r := []rune(key); len(r) > width {
+			key = string(r[:width-1]) + "…"
+		}
+		fmt.Fprintf(&b, "%-*s  %5s  %5s  %9s  %9s  %9s  %6s\n", width, key, cols[0], cols[1], cols[2], cols[3], cols[4], cols[5])
+	}
+
+	line(heading, "TASKS", "OVER", "ESTIMATE", "TRACKED", "VARIANCE", "VAR%")
+	for _, r := range rows {
+		line(r.Key, fmt.Sprint(r.Tasks), fmt.Sprint(r.Overruns), FormatDurationMs(r.EstimateMs),
+			FormatDurationMs(r.SpentMs), FormatVarianceMs(r.VarianceMs()), FormatVariancePct(r.VariancePct()))
+	}
+	return b.String()
+}
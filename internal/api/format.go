@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -129,8 +130,22 @@ func FormatTaskDetail(t Task) string {
 	fmt.Fprintf(&b, "Parent: %s\n", parent)
 	fmt.Fprintf(&b, "\n")
 
-	if t.Description != "" {
-		fmt.Fprintf(&b, "Description:\n%s\n\n", t.Description)
+	// Custom fields (only those with a value)
+	var fieldLines []string
+	for _, f := range t.CustomFields {
+		if v := FormatFieldValue(f); v != "" {
+			fieldLines = append(fieldLines, fmt.Sprintf("  %s: %s", f.Name, v))
+		}
+	}
+	if len(fieldLines) > 0 {
+		fmt.Fprintf(&b, "Custom Fields:\n%s\n\n", strings.Join(fieldLines, "\n"))
+	}
+
+	// Unlike comments, tasks are not returned as rich-text ops, so there is
+	// nothing for OpsToMarkdown to convert: the API renders the description
+	// as Markdown itself when asked. The plain description is the fallback.
+	if desc := Or(t.MarkdownDescription, t.Description); desc != "" {
+		fmt.Fprintf(&b, "Description:\n%s\n\n", desc)
 	}
 
 	fmt.Fprintf(&b, "URL: %s\n", t.URL)
@@ -163,22 +178,382 @@ func FormatTaskDetail(t Task) string {
 		}
 	}
 
+	// Checklists
+	if len(t.Checklists) > 0 {
+		fmt.Fprintf(&b, "\nChecklists (%d):\n", len(t.Checklists))
+		for _, c := range t.Checklists {
+			b.WriteString(Indent(FormatChecklist(c), "  "))
+		}
+	}
+
 	// Relationships
 	if len(t.Dependencies) > 0 || len(t.LinkedTasks) > 0 {
 		fmt.Fprintf(&b, "\nRelationships:\n")
 		if len(t.Dependencies) > 0 {
 			fmt.Fprintf(&b, "  Dependencies (%d):\n", len(t.Dependencies))
 			for _, d := range t.Dependencies {
-				fmt.Fprintf(&b, "    - %s (type: %d)\n", d.DependsOn, d.Type)
+				other, waitingOn := d.Other(t.ID)
+				direction := "blocking"
+				if waitingOn {
+					direction = "waiting on"
+				}
+				fmt.Fprintf(&b, "    - %s %s\n", direction, other)
 			}
 		}
 		if len(t.LinkedTasks) > 0 {
 			fmt.Fprintf(&b, "  Linked Tasks (%d):\n", len(t.LinkedTasks))
 			for _, l := range t.LinkedTasks {
-				fmt.Fprintf(&b, "    - %s (created: %s)\n", l.LinkID, FormatTimestamp(l.DateCreated))
+				fmt.Fprintf(&b, "    - %s (created: %s)\n", l.Other(t.ID), FormatTimestamp(l.DateCreated))
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// ChecklistProgress counts the resolved and total items of a checklist,
+// including nested items.
+func ChecklistProgress(items []ChecklistItem) (done, total int) {
+	for _, it := range items {
+		total++
+		if it.Resolved {
+			done++
+		}
+		d, t := ChecklistProgress(it.Children)
+		done += d
+		total += t
+	}
+	return done, total
+}
+
+// FormatChecklist formats a checklist with its completion count and items.
+func FormatChecklist(c Checklist) string {
+	var b strings.Builder
+	done, total := ChecklistProgress(c.Items)
+	fmt.Fprintf(&b, "%s (%d/%d)  [ID: %s]\n", c.Name, done, total, c.ID)
+	writeChecklistItems(&b, c.Items, "  ")
+	return b.String()
+}
+
+func writeChecklistItems(b *strings.Builder, items []ChecklistItem, prefix string) {
+	for _, it := range items {
+		mark := " "
+		if it.Resolved {
+			mark = "x"
+		}
+		assignee := ""
+		if it.Assignee != nil {
+			assignee = fmt.Sprintf(" (%s)", it.Assignee.Username)
+		}
+		fmt.Fprintf(b, "%s[%s] %s%s  [ID: %s]\n", prefix, mark, it.Name, assignee, it.ID)
+		writeChecklistItems(b, it.Children, prefix+"  ")
+	}
+}
+
+// Indent prefixes every non-empty line of s.
+func Indent(s, prefix string) string {
+	lines := strings.SplitAfter(s, "\n")
+	for i, l := range lines {
+		if l != "" && l != "\n" {
+			lines[i] = prefix + l
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// FormatTag formats a tag name with its colors.
+func FormatTag(t Tag) string {
+	return fmt.Sprintf("%-24s fg %-8s bg %s", t.Name, Or(t.TagFg, "-"), Or(t.TagBg, "-"))
+}
+
+// FormatFieldValue renders a task's custom field value for display. It
+// returns "" when the field has no value.
+func FormatFieldValue(f CustomField) string {
+	if len(f.Value) == 0 || string(f.Value) == "null" {
+		return ""
+	}
+
+	switch f.Type {
+	case "drop_down":
+		// The value is the option's orderindex, or its ID on newer fields.
+		var idx FlexInt
+		var id string
+		if json.Unmarshal(f.Value, &id) != nil {
+			_ = json.Unmarshal(f.Value, &idx)
+		}
+		for _, o := range f.TypeConfig.Options {
+			if (id != "" && o.ID == id) || (id == "" && o.OrderIndex == idx) {
+				return o.DisplayName()
+			}
+		}
+	case "labels":
+		var ids []string
+		if err := json.Unmarshal(f.Value, &ids); err == nil {
+			names := make([]string, 0, len(ids))
+			for _, id := range ids {
+				name := id
+				for _, o := range f.TypeConfig.Options {
+					if o.ID == id {
+						name = o.DisplayName()
+					}
+				}
+				names = append(names, name)
+			}
+			return strings.Join(names, ", ")
+		}
+	case "date":
+		var ms FlexInt64
+		if err := json.Unmarshal(f.Value, &ms); err == nil && ms != 0 {
+			return FormatTimestamp(strconv.FormatInt(int64(ms), 10))
+		}
+	case "users":
+		var users []User
+		if err := json.Unmarshal(f.Value, &users); err == nil {
+			names := make([]string, len(users))
+			for i, u := range users {
+				names[i] = u.Username
+			}
+			return strings.Join(names, ", ")
+		}
+	case "tasks", "list_relationship":
+		var tasks []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(f.Value, &tasks); err == nil {
+			names := make([]string, len(tasks))
+			for i, t := range tasks {
+				names[i] = fmt.Sprintf("%s (%s)", t.Name, t.ID)
+			}
+			return strings.Join(names, ", ")
+		}
+	case "progress":
+		var p struct {
+			Percent float64 `json:"percent_completed"`
+		}
+		if err := json.Unmarshal(f.Value, &p); err == nil {
+			return fmt.Sprintf("%g%%", p.Percent)
+		}
+	case "location":
+		var l struct {
+			Address string `json:"formatted_address"`
+		}
+		if err := json.Unmarshal(f.Value, &l); err == nil {
+			return l.Address
+		}
+	}
+
+	// Text, number, currency, checkbox and friends are plain scalars,
+	// sometimes string-encoded.
+	var str string
+	if err := json.Unmarshal(f.Value, &str); err == nil {
+		return str
+	}
+	return string(f.Value)
+}
+
+// TaskNode is a task together with its recursively fetched subtasks.
+type TaskNode struct {
+	Task     Task
+	Children []*TaskNode
+}
+
+// isClosed reports whether a task's status counts as done.
+func isClosed(t Task) bool {
+	return t.Status.Type == "closed" || t.Status.Type == "done"
+}
+
+// treeRollup sums estimates and time spent over a branch (including the
+// node itself) and counts its descendants and how many of them are closed.
+func treeRollup(n *TaskNode) (est, spent int64, closed, total int) {
+	if n.Task.TimeEstimate != nil {
+		est = *n.Task.TimeEstimate
+	}
+	if n.Task.TimeSpent != nil {
+		spent = *n.Task.TimeSpent
+	}
+	for _, c := range n.Children {
+		e, s, cl, t := treeRollup(c)
+		est += e
+		spent += s
+		closed += cl
+		total += t + 1
+		if isClosed(c.Task) {
+			closed++
+		}
+	}
+	return est, spent, closed, total
+}
+
+func formatTreeNode(n *TaskNode) string {
+	t := n.Task
+	id := t.ID
+	if t.CustomID != nil && *t.CustomID != "" {
+		id = *t.CustomID
+	}
+	assignees := make([]string, len(t.Assignees))
+	for i, a := range t.Assignees {
+		assignees[i] = a.Username
+	}
+	var est, spent int64
+	if t.TimeEstimate != nil {
+		est = *t.TimeEstimate
+	}
+	if t.TimeSpent != nil {
+		spent = *t.TimeSpent
+	}
+
+	line := fmt.Sprintf("%s %s [%s] (%s)  est %s, spent %s", id, t.Name, t.Status.Status,
+		Or(strings.Join(assignees, ", "), "Unassigned"), FormatDurationMs(est), FormatDurationMs(spent))
+	if len(n.Children) > 0 {
+		branchEst, branchSpent, closed, total := treeRollup(n)
+		line += fmt.Sprintf("  | branch: est %s, spent %s, %d/%d done (%d%%)",
+			FormatDurationMs(branchEst), FormatDurationMs(branchSpent), closed, total, closed*100/total)
+	}
+	return line
+}
+
+// FormatTaskTree renders a task and its nested subtasks using the same
+// box-drawing style as "space structure". Parents show the rolled-up
+// estimate and time spent of their branch and the share of closed
+// subtasks.
+func FormatTaskTree(root *TaskNode) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s\n", formatTreeNode(root))
+	var walk func(n *TaskNode, prefix string)
+	walk = func(n *TaskNode, prefix string) {
+		for i, c := range n.Children {
+			branch, next := "├── ", "│   "
+			if i == len(n.Children)-1 {
+				branch, next = "└── ", "    "
 			}
+			fmt.Fprintf(&b, "%s%s%s\n", prefix, branch, formatTreeNode(c))
+			walk(c, prefix+next)
 		}
 	}
+	walk(root, "")
+
+	est, spent, closed, total := treeRollup(root)
+	fmt.Fprintf(&b, "\nTotal: %d subtask(s), %d closed; estimated %s, spent %s\n",
+		total, closed, FormatDurationMs(est), FormatDurationMs(spent))
+
+	return b.String()
+}
+
+// FormatComment formats a comment with its markers, reactions, attachments
+// and mentions, followed by its replies indented beneath it.
+func FormatComment(c Comment) string {
+	var b strings.Builder
+
+	var markers []string
+	if c.Resolved {
+		markers = append(markers, "[resolved]")
+	}
+	if c.Assignee != nil {
+		markers = append(markers, fmt.Sprintf("[assigned to %s]", c.Assignee.Username))
+	}
+	header := fmt.Sprintf("--- %s  (%s)", c.User.Username, FormatTimestamp(c.Date))
+	if len(markers) > 0 {
+		header += "  " + strings.Join(markers, " ")
+	}
+	fmt.Fprintf(&b, "%s ---\n", header)
+	text := c.CommentText
+	if len(c.Comment) > 0 {
+		text = OpsToMarkdown(c.Comment)
+	}
+	fmt.Fprintf(&b, "%s\n", strings.TrimRight(text, "\n"))
+
+	var mentions, attachments []string
+	for _, op := range c.Comment {
+		switch {
+		case op.Type == "tag" && op.User != nil:
+			mentions = append(mentions, "@"+op.User.Username)
+		case op.Attachment != nil:
+			attachments = append(attachments, fmt.Sprintf("%s (%s)", Or(op.Attachment.Title, "attachment"), op.Attachment.URL))
+		}
+	}
+	if len(mentions) > 0 {
+		fmt.Fprintf(&b, "Mentions: %s\n", strings.Join(mentions, ", "))
+	}
+	if len(attachments) > 0 {
+		fmt.Fprintf(&b, "Attachments: %s\n", strings.Join(attachments, ", "))
+	}
+	if len(c.Reactions) > 0 {
+		fmt.Fprintf(&b, "Reactions: %s\n", formatReactions(c.Reactions))
+	}
+
+	fmt.Fprintf(&b, "ID: %s", c.ID)
+	if c.ReplyCount > 0 {
+		fmt.Fprintf(&b, "  (replies: %d)", int(c.ReplyCount))
+	}
+	fmt.Fprintf(&b, "\n")
+
+	for _, r := range c.Replies {
+		fmt.Fprintf(&b, "\n%s", Indent(FormatComment(r), "    "))
+	}
+
+	return b.String()
+}
+
+// formatReactions groups reactions by emoji, e.g. "+1 x2, tada x1".
+func formatReactions(reactions []Reaction) string {
+	var order []string
+	counts := map[string]int{}
+	for _, r := range reactions {
+		if counts[r.Reaction] == 0 {
+			order = append(order, r.Reaction)
+		}
+		counts[r.Reaction]++
+	}
+	parts := make([]string, len(order))
+	for i, r := range order {
+		parts[i] = fmt.Sprintf("%s x%d", r, counts[r])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FormatTimeEntry formats a time entry. For a running timer the duration
+// is the time elapsed since it was started.
+func FormatTimeEntry(e TimeEntry, now time.Time) string {
+	var b strings.Builder
+
+	durationMs, _ := strconv.ParseInt(e.Duration, 10, 64)
+	running := e.End == "" && durationMs < 0
+	if running {
+		startMs, _ := strconv.ParseInt(e.Start, 10, 64)
+		durationMs = now.UnixMilli() - startMs
+	}
+
+	task := "(no task)"
+	if e.Task != nil && e.Task.ID != "" {
+		id := e.Task.ID
+		if e.Task.CustomID != nil && *e.Task.CustomID != "" {
+			id = *e.Task.CustomID
+		}
+		task = fmt.Sprintf("%s %s", id, e.Task.Name)
+	}
+
+	fmt.Fprintf(&b, "%s\n", task)
+	if running {
+		fmt.Fprintf(&b, "  Running:  %s (since %s)\n", FormatDurationMs(durationMs), FormatTimestamp(e.Start))
+	} else {
+		fmt.Fprintf(&b, "  Duration: %s (%s - %s)\n", FormatDurationMs(durationMs), FormatTimestamp(e.Start), FormatTimestamp(e.End))
+	}
+	if e.Description != "" {
+		fmt.Fprintf(&b, "  %s\n", e.Description)
+	}
+	if len(e.Tags) > 0 {
+		tags := make([]string, len(e.Tags))
+		for i, t := range e.Tags {
+			tags[i] = t.Name
+		}
+		fmt.Fprintf(&b, "  Tags: %s\n", strings.Join(tags, ", "))
+	}
+	if e.Billable {
+		fmt.Fprintf(&b, "  Billable\n")
+	}
+	fmt.Fprintf(&b, "  ID: %s\n", e.ID)
 
 	return b.String()
 }
@@ -0,0 +1,491 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Edge kinds in a TaskGraph.
+const (
+	EdgeBlocks  = "blocks"  // From must be done before To
+	EdgeLinked  = "linked"  // undirected
+	EdgeSubtask = "subtask" // From is the parent of To
+)
+
+type GraphEdge struct {
+	From string
+	To   string
+	Kind string
+}
+
+// TaskGraph is a graph of tasks connected by dependencies, links and
+// parent/subtask relations. Nodes are keyed by internal task ID; edges may
+// reference tasks that were never fetched, which are rendered by ID only.
+type TaskGraph struct {
+	Tasks map[string]Task
+	Edges []GraphEdge
+
+	order  []string
+	nodes  map[string]bool
+	seen   map[GraphEdge]bool
+	cycles [][]string // cached by Cycles; reset when an edge is added
+	found  bool
+}
+
+func NewTaskGraph() *TaskGraph {
+	return &TaskGraph{
+		Tasks: map[string]Task{},
+		nodes: map[string]bool{},
+		seen:  map[GraphEdge]bool{},
+	}
+}
+
+// AddTask records a task's details. A copy without a name (e.g. a stub)
+// never replaces one that has it.
+func (g *TaskGraph) AddTask(t Task) {
+	g.addNode(t.ID)
+	if old, ok := g.Tasks[t.ID]; ok && old.Name != "" && t.Name == "" {
+		return
+	}
+	g.Tasks[t.ID] = t
+}
+
+// AddEdge adds an edge unless it already exists. Linked edges are
+// normalised so that A-B and B-A are the same edge.
+func (g *TaskGraph) AddEdge(from, to, kind string) {
+	if kind == EdgeLinked && to < from {
+		from, to = to, from
+	}
+	e := GraphEdge{From: from, To: to, Kind: kind}
+	if g.seen[e] {
+		return
+	}
+	g.seen[e] = true
+	g.found = false
+	g.addNode(from)
+	g.addNode(to)
+	g.Edges = append(g.Edges, e)
+}
+
+// AddRelations adds the edges described by a task's own fields and
+// returns the IDs of the tasks it is related to.
+func (g *TaskGraph) AddRelations(t Task) []string {
+	var related []string
+	for _, d := range t.Dependencies {
+		other, waitingOn := d.Other(t.ID)
+		if waitingOn {
+			g.AddEdge(other, t.ID, EdgeBlocks)
+		} else {
+			g.AddEdge(t.ID, other, EdgeBlocks)
+		}
+		related = append(related, other)
+	}
+	for _, l := range t.LinkedTasks {
+		other := l.Other(t.ID)
+		g.AddEdge(t.ID, other, EdgeLinked)
+		related = append(related, other)
+	}
+	if t.Parent != nil && *t.Parent != "" {
+		g.AddEdge(*t.Parent, t.ID, EdgeSubtask)
+		related = append(related, *t.Parent)
+	}
+	for _, st := range t.Subtasks {
+		g.AddTask(st)
+		g.AddEdge(t.ID, st.ID, EdgeSubtask)
+		related = append(related, st.ID)
+	}
+	return related
+}
+
+func (g *TaskGraph) addNode(id string) {
+	if g.nodes[id] {
+		return
+	}
+	g.nodes[id] = true
+	g.order = append(g.order, id)
+}
+
+func (g *TaskGraph) blockers() map[string][]string {
+	out := map[string][]string{}
+	for _, e := range g.Edges {
+		if e.Kind == EdgeBlocks {
+			out[e.From] = append(out[e.From], e.To)
+		}
+	}
+	return out
+}
+
+// Cycles returns the groups of tasks that block each other in a cycle,
+// found as the strongly connected components of the blocking edges. The
+// result is computed once per set of edges.
+func (g *TaskGraph) Cycles() [][]string {
+	if !g.found {
+		g.cycles = g.findCycles()
+		g.found = true
+	}
+	return g.cycles
+}
+
+func (g *TaskGraph) findCycles() [][]string {
+	succ := g.blockers()
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var cycles [][]string
+	next := 0
+
+	var strongConnect func(v string)
+	strongConnect = func(v string) {
+		index[v] = next
+		low[v] = next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range succ[v] {
+			if _, visited := index[w]; !visited {
+				strongConnect(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], index[w])
+			}
+		}
+
+		if low[v] == index[v] {
+			var scc []string
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				scc = append(scc, w)
+				if w == v {
+					break
+				}
+			}
+			selfLoop := false
+			for _, w := range succ[v] {
+				selfLoop = selfLoop || w == v
+			}
+			if len(scc) > 1 || selfLoop {
+				sort.Strings(scc)
+				cycles = append(cycles, scc)
+			}
+		}
+	}
+
+	for _, v := range g.order {
+		if _, visited := index[v]; !visited {
+			strongConnect(v)
+		}
+	}
+	return cycles
+}
+
+// isOpen reports whether a fetched task is not yet done. Tasks that were
+// never fetched have unknown status and are not considered open.
+func (g *TaskGraph) isOpen(id string) bool {
+	t, ok := g.Tasks[id]
+	if !ok || t.Status.Status == "" {
+		return false
+	}
+	return !isClosed(t)
+}
+
+// CriticalPath returns the longest chain of open tasks connected by
+// blocking edges, ignoring tasks that are part of a cycle. It returns nil
+// if no open task blocks another.
+func (g *TaskGraph) CriticalPath() []string {
+	inCycle := map[string]bool{}
+	for _, c := range g.Cycles() {
+		for _, id := range c {
+			inCycle[id] = true
+		}
+	}
+	usable := func(id string) bool { return g.isOpen(id) && !inCycle[id] }
+
+	succ := g.blockers()
+	longest := map[string][]string{}
+	var walk func(v string) []string
+	walk = func(v string) []string {
+		if p, ok := longest[v]; ok {
+			return p
+		}
+		best := []string{v}
+		for _, w := range succ[v] {
+			if !usable(w) {
+				continue
+			}
+			if p := walk(w); len(p)+1 > len(best) {
+				best = append([]string{v}, p...)
+			}
+		}
+		longest[v] = best
+		return best
+	}
+
+	var path []string
+	for _, v := range g.order {
+		if !usable(v) {
+			continue
+		}
+		if p := walk(v); len(p) > len(path) {
+			path = p
+		}
+	}
+	if len(path) < 2 {
+		return nil
+	}
+	return path
+}
+
+// GraphNodeLabel returns a one-line label for a task in the graph.
+func (g *TaskGraph) GraphNodeLabel(id string) string {
+	t, ok := g.Tasks[id]
+	if !ok || t.Name == "" {
+		return id
+	}
+	label := id
+	if t.CustomID != nil && *t.CustomID != "" {
+		label = *t.CustomID
+	}
+	return fmt.Sprintf("%s %s [%s]", label, t.Name, t.Status.Status)
+}
+
+// graphMarks collects the nodes and blocking edges to highlight.
+func (g *TaskGraph) graphMarks() (critNodes map[string]bool, critEdges map[GraphEdge]bool, cycleNodes map[string]bool) {
+	critNodes = map[string]bool{}
+	critEdges = map[GraphEdge]bool{}
+	cycleNodes = map[string]bool{}
+	path := g.CriticalPath()
+	for i, id := range path {
+		critNodes[id] = true
+		if i > 0 {
+			critEdges[GraphEdge{From: path[i-1], To: id, Kind: EdgeBlocks}] = true
+		}
+	}
+	for _, c := range g.Cycles() {
+		for _, id := range c {
+			cycleNodes[id] = true
+		}
+	}
+	return critNodes, critEdges, cycleNodes
+}
+
+// graphSummary describes cycles and the critical path as plain lines.
+func (g *TaskGraph) graphSummary() []string {
+	var lines []string
+	for _, c := range g.Cycles() {
+		lines = append(lines, "Cycle: "+strings.Join(c, " <-> "))
+	}
+	if path := g.CriticalPath(); path != nil {
+		labels := make([]string, len(path))
+		for i, id := range path {
+			labels[i] = g.GraphNodeLabel(id)
+		}
+		lines = append(lines, fmt.Sprintf("Critical path (%d tasks): %s", len(path), strings.Join(labels, " -> ")))
+	}
+	return lines
+}
+
+// FormatGraphDOT renders the graph in Graphviz DOT format. Closed tasks
+// are greyed out, the critical path is drawn in red and tasks in a
+// blocking cycle are filled orange.
+func FormatGraphDOT(g *TaskGraph) string {
+	var b strings.Builder
+	critNodes, critEdges, cycleNodes := g.graphMarks()
+	quote := func(s string) string {
+		return `"` + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`) + `"`
+	}
+
+	fmt.Fprintf(&b, "digraph tasks {\n")
+	fmt.Fprintf(&b, "  rankdir=LR;\n")
+	fmt.Fprintf(&b, "  node [shape=box, style=rounded];\n")
+	for _, line := range g.graphSummary() {
+		fmt.Fprintf(&b, "  // %s\n", line)
+	}
+	fmt.Fprintf(&b, "\n")
+
+	for _, id := range g.order {
+		var attrs []string
+		attrs = append(attrs, "label="+quote(g.GraphNodeLabel(id)))
+		switch {
+		case cycleNodes[id]:
+			attrs = append(attrs, `style="rounded,filled"`, "fillcolor=orange")
+		case g.Tasks[id].Name != "" && !g.isOpen(id):
+			attrs = append(attrs, `style="rounded,filled"`, "fillcolor=lightgrey")
+		}
+		if critNodes[id] {
+			attrs = append(attrs, "color=red", "penwidth=2")
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", quote(id), strings.Join(attrs, ", "))
+	}
+	fmt.Fprintf(&b, "\n")
+
+	for _, e := range g.Edges {
+		var attrs []string
+		switch e.Kind {
+		case EdgeBlocks:
+			attrs = append(attrs, `label="blocks"`)
+			if critEdges[e] {
+				attrs = append(attrs, "color=red", "penwidth=2")
+			}
+		case EdgeLinked:
+			attrs = append(attrs, `label="linked"`, "dir=none", "style=dashed")
+		case EdgeSubtask:
+			attrs = append(attrs, `label="subtask"`, "style=dotted")
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", quote(e.From), quote(e.To), strings.Join(attrs, ", "))
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	return b.String()
+}
+
+// FormatGraphMermaid renders the graph as a Mermaid flowchart.
+func FormatGraphMermaid(g *TaskGraph) string {
+	var b strings.Builder
+	critNodes, critEdges, cycleNodes := g.graphMarks()
+
+	ids := map[string]string{}
+	for i, id := range g.order {
+		ids[id] = fmt.Sprintf("n%d", i)
+	}
+	escape := func(s string) string {
+		return strings.ReplaceAll(s, `"`, "#quot;")
+	}
+
+	fmt.Fprintf(&b, "flowchart LR\n")
+	for _, line := range g.graphSummary() {
+		fmt.Fprintf(&b, "  %%%% %s\n", line)
+	}
+	for _, id := range g.order {
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", ids[id], escape(g.GraphNodeLabel(id)))
+	}
+
+	var critLinks []string
+	for i, e := range g.Edges {
+		switch e.Kind {
+		case EdgeBlocks:
+			fmt.Fprintf(&b, "  %s -->|blocks| %s\n", ids[e.From], ids[e.To])
+			if critEdges[e] {
+				critLinks = append(critLinks, fmt.Sprint(i))
+			}
+		case EdgeLinked:
+			fmt.Fprintf(&b, "  %s ---|linked| %s\n", ids[e.From], ids[e.To])
+		case EdgeSubtask:
+			fmt.Fprintf(&b, "  %s -.->|subtask| %s\n", ids[e.From], ids[e.To])
+		}
+	}
+
+	var closed, crit, cyc []string
+	for _, id := range g.order {
+		if g.Tasks[id].Name != "" && !g.isOpen(id) {
+			closed = append(closed, ids[id])
+		}
+		if critNodes[id] {
+			crit = append(crit, ids[id])
+		}
+		if cycleNodes[id] {
+			cyc = append(cyc, ids[id])
+		}
+	}
+	if len(closed) > 0 {
+		fmt.Fprintf(&b, "  classDef closed fill:#eee,color:#888\n")
+		fmt.Fprintf(&b, "  class %s closed\n", strings.Join(closed, ","))
+	}
+	if len(cyc) > 0 {
+		fmt.Fprintf(&b, "  classDef cycle fill:#fc9\n")
+		fmt.Fprintf(&b, "  class %s cycle\n", strings.Join(cyc, ","))
+	}
+	if len(crit) > 0 {
+		fmt.Fprintf(&b, "  classDef critical stroke:#d00,stroke-width:3px\n")
+		fmt.Fprintf(&b, "  class %s critical\n", strings.Join(crit, ","))
+	}
+	if len(critLinks) > 0 {
+		fmt.Fprintf(&b, "  linkStyle %s stroke:#d00,stroke-width:3px\n", strings.Join(critLinks, ","))
+	}
+
+	return b.String()
+}
+
+// FormatGraphTree renders the graph as an ASCII tree rooted at roots,
+// using the same box-drawing style as "space structure". Every task is
+// expanded once; later occurrences are marked as already shown.
+func FormatGraphTree(g *TaskGraph, roots []string) string {
+	var b strings.Builder
+	critNodes, _, cycleNodes := g.graphMarks()
+
+	type neighbor struct {
+		id       string
+		relation string
+	}
+	adj := map[string][]neighbor{}
+	for _, e := range g.Edges {
+		switch e.Kind {
+		case EdgeBlocks:
+			adj[e.From] = append(adj[e.From], neighbor{e.To, "blocking"})
+			adj[e.To] = append(adj[e.To], neighbor{e.From, "waiting on"})
+		case EdgeLinked:
+			adj[e.From] = append(adj[e.From], neighbor{e.To, "linked"})
+			adj[e.To] = append(adj[e.To], neighbor{e.From, "linked"})
+		case EdgeSubtask:
+			adj[e.From] = append(adj[e.From], neighbor{e.To, "subtask"})
+			adj[e.To] = append(adj[e.To], neighbor{e.From, "parent"})
+		}
+	}
+
+	label := func(id string) string {
+		l := g.GraphNodeLabel(id)
+		if cycleNodes[id] {
+			l += "  (cycle!)"
+		}
+		if critNodes[id] {
+			l += "  *critical*"
+		}
+		return l
+	}
+
+	shown := map[string]bool{}
+	var walk func(id, from, prefix string)
+	walk = func(id, from, prefix string) {
+		shown[id] = true
+		var children []neighbor
+		for _, n := range adj[id] {
+			// The edge back to where we came from is already on screen.
+			if n.id != from {
+				children = append(children, n)
+			}
+		}
+		for i, n := range children {
+			branch, next := "├── ", "│   "
+			if i == len(children)-1 {
+				branch, next = "└── ", "    "
+			}
+			if shown[n.id] {
+				fmt.Fprintf(&b, "%s%s%s: %s (see above)\n", prefix, branch, n.relation, n.id)
+				continue
+			}
+			fmt.Fprintf(&b, "%s%s%s: %s\n", prefix, branch, n.relation, label(n.id))
+			walk(n.id, id, prefix+next)
+		}
+	}
+
+	for i, id := range roots {
+		if shown[id] {
+			continue
+		}
+		if i > 0 {
+			fmt.Fprintf(&b, "\n")
+		}
+		fmt.Fprintf(&b, "%s\n", label(id))
+		walk(id, "", "")
+	}
+
+	if summary := g.graphSummary(); len(summary) > 0 {
+		fmt.Fprintf(&b, "\n%s\n", strings.Join(summary, "\n"))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTaskGraphCyclesAndCriticalPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][2]string // blocking edges, from blocks to
+		closed   []string
+		cycles   [][]string
+		critical []string
+	}{
+		{
+			name:     "dag",
+			edges:    [][2]string{{"a", "b"}, {"b", "c"}, {"a", "c"}, {"x", "c"}},
+			critical: []string{"a", "b", "c"},
+		},
+		{
+			name:     "closed task breaks the path",
+			edges:    [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}},
+			closed:   []string{"b"},
+			critical: []string{"c", "d"},
+		},
+		{
+			name:   "self-loop",
+			edges:  [][2]string{{"a", "a"}, {"a", "b"}},
+			cycles: [][]string{{"a"}},
+		},
+		{
+			name:   "two disjoint cycles",
+			edges:  [][2]string{{"a", "b"}, {"b", "a"}, {"c", "d"}, {"d", "e"}, {"e", "c"}},
+			cycles: [][]string{{"a", "b"}, {"c", "d", "e"}},
+		},
+		{
+			name:     "path through a cycle",
+			edges:    [][2]string{{"a", "b"}, {"b", "c"}, {"c", "b"}, {"c", "d"}, {"d", "e"}},
+			cycles:   [][]string{{"b", "c"}},
+			critical: []string{"d", "e"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewTaskGraph()
+			closed := map[string]bool{}
+			for _, id := range tt.closed {
+				closed[id] = true
+			}
+			for _, e := range tt.edges {
+				for _, id := range e {
+					status := Status{Status: "open", Type: "open"}
+					if closed[id] {
+						status = Status{Status: "done", Type: "closed"}
+					}
+					g.AddTask(Task{ID: id, Name: "Task " + id, Status: status})
+				}
+				g.AddEdge(e[0], e[1], EdgeBlocks)
+			}
+
+			if got := g.Cycles(); !reflect.DeepEqual(got, tt.cycles) {
+				t.Errorf("Cycles() = %v, want %v", got, tt.cycles)
+			}
+			if got := g.CriticalPath(); !reflect.DeepEqual(got, tt.critical) {
+				t.Errorf("CriticalPath() = %v, want %v", got, tt.critical)
+			}
+		})
+	}
+}
+
+func TestTaskGraphCyclesUpdateWithEdges(t *testing.T) {
+	g := NewTaskGraph()
+	g.AddEdge("a", "b", EdgeBlocks)
+	if got := g.Cycles(); got != nil {
+		t.Fatalf("Cycles() = %v, want none", got)
+	}
+	g.AddEdge("b", "a", EdgeBlocks)
+	if got, want := g.Cycles(), [][]string{{"a", "b"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Cycles() after adding b->a = %v, want %v", got, want)
+	}
+}
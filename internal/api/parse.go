@@ -0,0 +1,161 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTime parses a human-entered date or time relative to now, in the
+// local time zone. Accepted forms:
+//
+//	2026-03-14, 2026-03-14 09:30[:00], RFC 3339
+//	today, tomorrow, yesterday, now (optionally followed by HH:MM)
+//	a millisecond Unix timestamp
+//
+// The second return value reports whether a time of day was given.
+func ParseTime(s string, now time.Time) (time.Time, bool, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, false, fmt.Errorf("empty date")
+	}
+
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil && len(s) >= 10 {
+		return time.UnixMilli(ms), true, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, true, nil
+	}
+
+	datePart, clockPart, _ := strings.Cut(s, " ")
+	clockPart = strings.TrimSpace(clockPart)
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	var day time.Time
+	switch strings.ToLower(datePart) {
+	case "now":
+		if clockPart != "" {
+			return time.Time{}, false, fmt.Errorf("invalid date %q", s)
+		}
+		return now, true, nil
+	case "today":
+		day = today
+	case "tomorrow":
+		day = today.AddDate(0, 0, 1)
+	case "yesterday":
+		day = today.AddDate(0, 0, -1)
+	default:
+		d, err := time.ParseInLocation("2006-01-02", datePart, now.Location())
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date %q (expected YYYY-MM-DD [HH:MM], today, tomorrow or yesterday)", s)
+		}
+		day = d
+	}
+
+	if clockPart == "" {
+		return day, false, nil
+	}
+	clock, err := time.Parse("15:04", clockPart)
+	if err != nil {
+		if clock, err = time.Parse("15:04:05", clockPart); err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid time of day %q (expected HH:MM)", clockPart)
+		}
+	}
+	return day.Add(time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute +
+		time.Duration(clock.Second())*time.Second), true, nil
+}
+
+// ParseDuration parses a human duration such as "1h30m", "1h 30m", "45m",
+// "1.5h", "2h" or "1:30:00" into milliseconds. It is the reverse of
+// FormatDurationMs. A bare number is taken as minutes.
+func ParseDuration(arg string) (int64, error) {
+	s := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(arg), " ", ""))
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	if parts := strings.Split(s, ":"); len(parts) == 2 || len(parts) == 3 {
+		units := []string{"h", "m", "s"}
+		for i := range parts {
+			parts[i] += units[i]
+		}
+		s = strings.Join(parts, "")
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		s = strconv.FormatFloat(n, 'f', -1, 64) + "m"
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q (expected e.g. 1h30m, 45m or 1.5h)", arg)
+	}
+	return d.Milliseconds(), nil
+}
+
+// ParseRange resolves a named period to the half-open interval
+// [start, end). Known names are today, yesterday, this-week, last-week,
+// this-month, last-month, this-year and last-year; weeks start on Monday.
+// ok is false if s is not a known name.
+func ParseRange(s string, now time.Time) (start, end time.Time, ok bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	yearStart := time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location())
+
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "today":
+		return today, today.AddDate(0, 0, 1), true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), today, true
+	case "this-week":
+		return weekStart, weekStart.AddDate(0, 0, 7), true
+	case "last-week":
+		return weekStart.AddDate(0, 0, -7), weekStart, true
+	case "this-month":
+		return monthStart, monthStart.AddDate(0, 1, 0), true
+	case "last-month":
+		return monthStart.AddDate(0, -1, 0), monthStart, true
+	case "this-year":
+		return yearStart, yearStart.AddDate(1, 0, 0), true
+	case "last-year":
+		return yearStart.AddDate(-1, 0, 0), yearStart, true
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// ParseTimeRange resolves --from/--to style bounds to the half-open
+// interval [start, end). Either bound may be empty, leaving it zero. A
+// named period as from with an empty to covers the whole period; a date
+// without a time of day as to includes that entire day.
+func ParseTimeRange(from, to string, now time.Time) (start, end time.Time, err error) {
+	if from != "" {
+		if rs, re, ok := ParseRange(from, now); ok {
+			start = rs
+			if to == "" {
+				end = re
+			}
+		} else if start, _, err = ParseTime(from, now); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	if to != "" {
+		if _, re, ok := ParseRange(to, now); ok {
+			end = re
+		} else {
+			t, hasTime, err := ParseTime(to, now)
+			if err != nil {
+				return time.Time{}, time.Time{}, err
+			}
+			end = t
+			if !hasTime {
+				end = t.AddDate(0, 0, 1)
+			}
+		}
+	}
+
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("end of range %s is not after start %s", end.Format("2006-01-02 15:04"), start.Format("2006-01-02 15:04"))
+	}
+	return start, end, nil
+}
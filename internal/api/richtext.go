@@ -0,0 +1,502 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ClickUp comments are stored as a list of rich-text ops in the style of
+// Quill deltas: inline formatting is set on each run of text, and block
+// formatting (lists, headers, quotes, code blocks) is set on the "\n" op
+// that ends a line. OpsToMarkdown and MarkdownToOps convert between that
+// representation and Markdown, one line at a time, so that converting in
+// both directions preserves the content.
+
+// OpAttributes is the formatting of a CommentOp.
+type OpAttributes struct {
+	Bold       bool          `json:"bold,omitempty"`
+	Italic     bool          `json:"italic,omitempty"`
+	Strike     bool          `json:"strike,omitempty"`
+	Underline  bool          `json:"underline,omitempty"`
+	Code       bool          `json:"code,omitempty"`
+	Link       string        `json:"link,omitempty"`
+	Header     int           `json:"header,omitempty"`
+	List       ListAttr      `json:"list,omitempty"`
+	Blockquote BlockAttr     `json:"blockquote,omitempty"`
+	CodeBlock  CodeBlockAttr `json:"code-block,omitempty"`
+	Indent     int           `json:"indent,omitempty"`
+}
+
+// ListAttr is the list type of a line: bullet, ordered, checked or
+// unchecked. ClickUp wraps it in an object ({"list": "bullet"}); a bare
+// string is accepted as well.
+type ListAttr string
+
+func (l *ListAttr) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*l = ListAttr(s)
+		return nil
+	}
+	var obj struct {
+		List string `json:"list"`
+	}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return err
+	}
+	*l = ListAttr(obj.List)
+	return nil
+}
+
+func (l ListAttr) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"list": string(l)})
+}
+
+// BlockAttr is a block flag ClickUp encodes as an empty object.
+type BlockAttr bool
+
+func (a *BlockAttr) UnmarshalJSON(b []byte) error {
+	var v bool
+	if err := json.Unmarshal(b, &v); err == nil {
+		*a = BlockAttr(v)
+		return nil
+	}
+	*a = string(b) != "null"
+	return nil
+}
+
+func (a BlockAttr) MarshalJSON() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+// CodeBlockAttr is the language of a code block line ("plain" if none).
+// ClickUp wraps it in an object ({"code-block": "plain"}).
+type CodeBlockAttr string
+
+func (c *CodeBlockAttr) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*c = CodeBlockAttr(s)
+		return nil
+	}
+	var v bool
+	if err := json.Unmarshal(b, &v); err == nil {
+		if v {
+			*c = "plain"
+		}
+		return nil
+	}
+	var obj struct {
+		Lang string `json:"code-block"`
+	}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return err
+	}
+	*c = CodeBlockAttr(Or(obj.Lang, "plain"))
+	return nil
+}
+
+func (c CodeBlockAttr) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"code-block": string(c)})
+}
+
+// inline returns only the inline part of the attributes.
+func (a OpAttributes) inline() OpAttributes {
+	return OpAttributes{Bold: a.Bold, Italic: a.Italic, Strike: a.Strike, Underline: a.Underline, Code: a.Code, Link: a.Link}
+}
+
+// mdLine is one line of a rich-text document.
+type mdLine struct {
+	text  string // Markdown-formatted inline content
+	raw   string // unformatted content, used inside code blocks
+	block OpAttributes
+}
+
+// OpsToMarkdown converts rich-text ops to Markdown.
+func OpsToMarkdown(ops []CommentOp) string {
+	var lines []mdLine
+	var text, raw strings.Builder
+
+	for _, op := range ops {
+		var attrs OpAttributes
+		if op.Attributes != nil {
+			attrs = *op.Attributes
+		}
+
+		if op.Type != "" && op.Type != "text" {
+			s := opEmbedText(op)
+			text.WriteString(s)
+			raw.WriteString(s)
+			continue
+		}
+
+		segments := strings.Split(op.Text, "\n")
+		for i, seg := range segments {
+			if seg != "" {
+				text.WriteString(formatInline(seg, attrs.inline()))
+				raw.WriteString(seg)
+			}
+			if i < len(segments)-1 {
+				// A newline ends the line; its attributes format the block.
+				lines = append(lines, mdLine{text: text.String(), raw: raw.String(), block: attrs})
+				text.Reset()
+				raw.Reset()
+			}
+		}
+	}
+	if text.Len() > 0 {
+		lines = append(lines, mdLine{text: text.String(), raw: raw.String()})
+	}
+
+	var out []string
+	ordered := map[int]int{}
+	inCode := false
+	for _, l := range lines {
+		if l.block.CodeBlock != "" {
+			if !inCode {
+				lang := string(l.block.CodeBlock)
+				if lang == "plain" {
+					lang = ""
+				}
+				out = append(out, "```"+lang)
+				inCode = true
+			}
+			out = append(out, l.raw)
+			continue
+		}
+		if inCode {
+			out = append(out, "```")
+			inCode = false
+		}
+
+		pad := strings.Repeat("  ", l.block.Indent)
+		switch {
+		case l.block.List != "":
+			var marker string
+			switch l.block.List {
+			case "ordered":
+				ordered[l.block.Indent]++
+				marker = fmt.Sprintf("%d.", ordered[l.block.Indent])
+			case "checked":
+				marker = "- [x]"
+			case "unchecked":
+				marker = "- [ ]"
+			default:
+				marker = "-"
+			}
+			out = append(out, pad+marker+" "+l.text)
+			continue
+		case l.block.Header > 0:
+			out = append(out, strings.Repeat("#", l.block.Header)+" "+l.text)
+		case bool(l.block.Blockquote):
+			out = append(out, "> "+l.text)
+		default:
+			out = append(out, l.text)
+		}
+		ordered = map[int]int{}
+	}
+	if inCode {
+		out = append(out, "```")
+	}
+
+	return strings.TrimRight(strings.Join(out, "\n"), "\n")
+}
+
+// opEmbedText renders a non-text op (mention, attachment, ...) inline.
+func opEmbedText(op CommentOp) string {
+	switch op.Type {
+	case "tag":
+		if op.User != nil {
+			return "@" + op.User.Username
+		}
+	case "task_mention":
+		if op.TaskMention != nil {
+			return "https://app.clickup.com/t/" + op.TaskMention.TaskID
+		}
+	case "attachment":
+		if op.Attachment != nil {
+			return fmt.Sprintf("[%s](%s)", Or(op.Attachment.Title, "attachment"), op.Attachment.URL)
+		}
+	case "bookmark":
+		if op.Bookmark != nil {
+			return op.Bookmark.URL
+		}
+	}
+	return op.Text
+}
+
+// formatInline wraps s in the Markdown for attrs. Surrounding whitespace
+// is kept outside the markers, where Markdown requires it.
+func formatInline(s string, attrs OpAttributes) string {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" || attrs == (OpAttributes{}) {
+		return s
+	}
+	lead := s[:strings.Index(s, trimmed)]
+	trail := s[len(lead)+len(trimmed):]
+
+	out := trimmed
+	if attrs.Code {
+		out = "`" + out + "`"
+	}
+	if attrs.Strike {
+		out = "~~" + out + "~~"
+	}
+	if attrs.Italic {
+		out = "*" + out + "*"
+	}
+	if attrs.Bold {
+		out = "**" + out + "**"
+	}
+	if attrs.Link != "" {
+		out = "[" + out + "](" + attrs.Link + ")"
+	}
+	return lead + out + trail
+}
+
+var (
+	mdHeader  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	mdTask    = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s+(.*)$`)
+	mdBullet  = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	mdOrdered = regexp.MustCompile(`^(\s*)\d+[.)]\s+(.*)$`)
+	mdQuote   = regexp.MustCompile(`^>\s?(.*)$`)
+	mdFence   = regexp.MustCompile("^```\\s*(\\S*)\\s*$")
+)
+
+// MarkdownToOps converts Markdown to rich-text ops suitable for posting as
+// a comment. It understands headers, block quotes, bullet, numbered and
+// task lists, fenced code blocks, and inline bold, italic, strikethrough,
+// code and links.
+func MarkdownToOps(md string) []CommentOp {
+	var ops []CommentOp
+	newline := func(block OpAttributes) {
+		op := CommentOp{Text: "\n"}
+		if block != (OpAttributes{}) {
+			b := block
+			op.Attributes = &b
+		}
+		ops = append(ops, op)
+	}
+
+	var codeLang string
+	inCode := false
+	for _, line := range strings.Split(strings.TrimRight(md, "\n"), "\n") {
+		if m := mdFence.FindStringSubmatch(line); m != nil {
+			inCode = !inCode
+			codeLang = Or(m[1], "plain")
+			continue
+		}
+		if inCode {
+			if line != "" {
+				ops = append(ops, CommentOp{Text: line})
+			}
+			newline(OpAttributes{CodeBlock: CodeBlockAttr(codeLang)})
+			continue
+		}
+
+		var block OpAttributes
+		content := line
+		if m := mdHeader.FindStringSubmatch(line); m != nil {
+			block.Header = len(m[1])
+			content = m[2]
+		} else if m := mdTask.FindStringSubmatch(line); m != nil {
+			block.Indent = len(m[1]) / 2
+			block.List = "unchecked"
+			if m[2] != " " {
+				block.List = "checked"
+			}
+			content = m[3]
+		} else if m := mdBullet.FindStringSubmatch(line); m != nil {
+			block.Indent = len(m[1]) / 2
+			block.List = "bullet"
+			content = m[2]
+		} else if m := mdOrdered.FindStringSubmatch(line); m != nil {
+			block.Indent = len(m[1]) / 2
+			block.List = "ordered"
+			content = m[2]
+		} else if m := mdQuote.FindStringSubmatch(line); m != nil {
+			block.Blockquote = true
+			content = m[1]
+		}
+
+		ops = append(ops, parseInline(content, OpAttributes{})...)
+		newline(block)
+	}
+
+	return mergeOps(ops)
+}
+
+// inlineMarkers are tried in order at each position; longer markers first
+// so that ** is not read as two *.
+var inlineMarkers = []struct {
+	open, close string
+	apply       func(*OpAttributes)
+}{
+	{"`", "`", func(a *OpAttributes) { a.Code = true }},
+	{"**", "**", func(a *OpAttributes) { a.Bold = true }},
+	{"__", "__", func(a *OpAttributes) { a.Bold = true }},
+	{"~~", "~~", func(a *OpAttributes) { a.Strike = true }},
+	{"*", "*", func(a *OpAttributes) { a.Italic = true }},
+	{"_", "_", func(a *OpAttributes) { a.Italic = true }},
+}
+
+// parseInline converts inline Markdown to ops carrying attrs plus whatever
+// formatting the Markdown adds. Unmatched markers are kept as text.
+func parseInline(s string, attrs OpAttributes) []CommentOp {
+	var ops []CommentOp
+	var plain strings.Builder
+	flush := func() {
+		if plain.Len() > 0 {
+			ops = append(ops, newTextOp(plain.String(), attrs))
+			plain.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		// Links: [text](url)
+		if s[i] == '[' {
+			if end := strings.Index(s[i:], "]("); end > 0 {
+				if close := strings.IndexByte(s[i+end+2:], ')'); close >= 0 {
+					label := s[i+1 : i+end]
+					link := s[i+end+2 : i+end+2+close]
+					flush()
+					inner := attrs
+					inner.Link = link
+					ops = append(ops, parseInline(label, inner)...)
+					i += end + 2 + close + 1
+					continue
+				}
+			}
+		}
+
+		matched := false
+		for _, m := range inlineMarkers {
+			if !strings.HasPrefix(s[i:], m.open) {
+				continue
+			}
+			start := i + len(m.open)
+			var end int
+			if m.open == "`" {
+				end = strings.Index(s[start:], m.close)
+				if end > 0 {
+					end += start
+				}
+			} else if canOpen(s, i, m.open) {
+				end = findClose(s, start, m.close)
+			}
+			if end <= start {
+				continue
+			}
+			flush()
+			inner := attrs
+			m.apply(&inner)
+			if inner.Code {
+				ops = append(ops, newTextOp(s[start:end], inner))
+			} else {
+				ops = append(ops, parseInline(s[start:end], inner)...)
+			}
+			i = end + len(m.close)
+			matched = true
+			break
+		}
+		if matched {
+			continue
+		}
+
+		plain.WriteByte(s[i])
+		i++
+	}
+	flush()
+
+	return ops
+}
+
+// canOpen reports whether the emphasis marker at s[i:] can open a span,
+// following CommonMark's flanking rules: it must be followed by a
+// non-space character, and "_" cannot open inside a word.
+func canOpen(s string, i int, marker string) bool {
+	next, _ := utf8.DecodeRuneInString(s[i+len(marker):])
+	if next == utf8.RuneError || unicode.IsSpace(next) {
+		return false
+	}
+	if marker[0] == '_' {
+		prev, _ := utf8.DecodeLastRuneInString(s[:i])
+		return !isWordRune(prev)
+	}
+	return true
+}
+
+// canClose is the closing counterpart of canOpen: the marker at s[i:]
+// must follow a non-space character, and "_" cannot close inside a word.
+func canClose(s string, i int, marker string) bool {
+	prev, _ := utf8.DecodeLastRuneInString(s[:i])
+	if prev == utf8.RuneError || unicode.IsSpace(prev) {
+		return false
+	}
+	if marker[0] == '_' {
+		next, _ := utf8.DecodeRuneInString(s[i+len(marker):])
+		return !isWordRune(next)
+	}
+	return true
+}
+
+// findClose returns the index of the first marker after start that can
+// close a span, or -1. A single marker skips doubled ones, so that "*"
+// does not close on the "**" of nested bold.
+func findClose(s string, start int, marker string) int {
+	for j := start; j < len(s); {
+		k := strings.Index(s[j:], marker)
+		if k < 0 {
+			return -1
+		}
+		k += j
+		if len(marker) == 1 && strings.HasPrefix(s[k:], marker+marker) {
+			j = k + 2
+			continue
+		}
+		if k > start && canClose(s, k, marker) {
+			return k
+		}
+		j = k + 1
+	}
+	return -1
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func newTextOp(text string, attrs OpAttributes) CommentOp {
+	op := CommentOp{Text: text}
+	if attrs != (OpAttributes{}) {
+		a := attrs
+		op.Attributes = &a
+	}
+	return op
+}
+
+// mergeOps joins adjacent text ops with identical formatting.
+func mergeOps(ops []CommentOp) []CommentOp {
+	var out []CommentOp
+	for _, op := range ops {
+		if n := len(out); n > 0 && op.Type == "" && out[n-1].Type == "" &&
+			!strings.Contains(op.Text, "\n") && !strings.Contains(out[n-1].Text, "\n") &&
+			sameAttrs(out[n-1].Attributes, op.Attributes) {
+			out[n-1].Text += op.Text
+			continue
+		}
+		out = append(out, op)
+	}
+	return out
+}
+
+func sameAttrs(a, b *OpAttributes) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	tests := []string{
+		"plain text",
+		"set my_var_name and other_thing_here",
+		"see /srv/app_data/file_name.txt or https://example.com/a_b_c",
+		"2 * 3 * 4 and a * b",
+		"**bold**, *italic*, ~~gone~~ and `co_de`",
+		"mixed **bold *and italic*** text",
+		"a [link_name](https://example.com/x_y) here",
+		"# Header\n\n> quoted *text*\n\n- one\n- two_items\n  - nested\n\n1. first\n2. second\n\n- [x] done\n- [ ] todo",
+		"```go\nfunc snake_case() {}\n```",
+	}
+
+	for _, md := range tests {
+		ops := MarkdownToOps(md)
+		if got := OpsToMarkdown(ops); got != md {
+			t.Errorf("OpsToMarkdown(MarkdownToOps(%q)) = %q", md, got)
+		}
+		again := MarkdownToOps(OpsToMarkdown(ops))
+		want, _ := json.Marshal(ops)
+		got, _ := json.Marshal(again)
+		if string(got) != string(want) {
+			t.Errorf("ops for %q changed on round trip:\n got %s\nwant %s", md, got, want)
+		}
+	}
+}
+
+func TestMarkdownToOpsIntraword(t *testing.T) {
+	tests := []struct {
+		md     string
+		italic string // text expected to be italic, "" for none
+	}{
+		{"set my_var_name and other_thing_here", ""},
+		{"2*3*4", "3"},
+		{"a * b * c", ""},
+		{"_x_ and foo_bar_", "x"},
+		{"*word*", "word"},
+		{"a* b*", ""},
+	}
+
+	for _, tt := range tests {
+		ops := MarkdownToOps(tt.md)
+		italic := ""
+		text := ""
+		for _, op := range ops {
+			text += op.Text
+			if op.Attributes != nil && op.Attributes.Italic {
+				italic += op.Text
+			}
+		}
+		if italic != tt.italic {
+			t.Errorf("MarkdownToOps(%q): italic %q, want %q", tt.md, italic, tt.italic)
+		}
+		if tt.italic == "" && text != tt.md+"\n" {
+			t.Errorf("MarkdownToOps(%q): text %q, want it unchanged", tt.md, text)
+		}
+	}
+}
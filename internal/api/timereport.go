@@ -0,0 +1,219 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReportGroupings lists the keys time entries can be grouped by.
+var ReportGroupings = []string{"user", "task", "list", "tag", "task-tag", "day", "week"}
+
+// ReportRow is one group in a time report, with the totals of every entry
+// in it and, for all but the last grouping level, its subgroups.
+type ReportRow struct {
+	Key        string       `json:"key"`
+	Entries    int          `json:"entries"`
+	TotalMs    int64        `json:"total_ms"`
+	BillableMs int64        `json:"billable_ms"`
+	Children   []*ReportRow `json:"children,omitempty"`
+
+	chronological bool
+	index         map[string]*ReportRow
+}
+
+// NonBillableMs returns the tracked time not marked billable.
+func (r *ReportRow) NonBillableMs() int64 {
+	return r.TotalMs - r.BillableMs
+}
+
+func (r *ReportRow) add(durationMs int64, billable bool) {
+	r.Entries++
+	r.TotalMs += durationMs
+	if billable {
+		r.BillableMs += durationMs
+	}
+}
+
+func (r *ReportRow) child(key string, chronological bool) *ReportRow {
+	if r.index == nil {
+		r.index = map[string]*ReportRow{}
+	}
+	c, ok := r.index[key]
+	if !ok {
+		c = &ReportRow{Key: key, chronological: chronological}
+		r.index[key] = c
+		r.Children = append(r.Children, c)
+	}
+	return c
+}
+
+// reportKeys returns the group keys of e for a grouping. Tag groupings can
+// yield several keys, so an entry with two tags counts towards both.
+func reportKeys(e TimeEntry, by string) []string {
+	switch by {
+	case "user":
+		return []string{Or(e.User.Username, strconv.Itoa(e.User.ID))}
+	case "task":
+		if e.Task == nil || e.Task.ID == "" {
+			return []string{"(no task)"}
+		}
+		id := e.Task.ID
+		if e.Task.CustomID != nil && *e.Task.CustomID != "" {
+			id = *e.Task.CustomID
+		}
+		return []string{fmt.Sprintf("%s %s", id, e.Task.Name)}
+	case "list":
+		if e.TaskLocation == nil || e.TaskLocation.ListName == "" {
+			return []string{"(no list)"}
+		}
+		return []string{e.TaskLocation.ListName}
+	case "tag", "task-tag":
+		tags := e.Tags
+		if by == "task-tag" {
+			tags = e.TaskTags
+		}
+		if len(tags) == 0 {
+			return []string{"(no tag)"}
+		}
+		keys := make([]string, len(tags))
+		for i, t := range tags {
+			keys[i] = t.Name
+		}
+		return keys
+	case "day", "week":
+		ms, _ := strconv.ParseInt(e.Start, 10, 64)
+		t := time.UnixMilli(ms)
+		if by == "day" {
+			return []string{t.Format("2006-01-02")}
+		}
+		year, week := t.ISOWeek()
+		return []string{fmt.Sprintf("%d-W%02d", year, week)}
+	}
+	return []string{"?"}
+}
+
+// BuildTimeReport groups entries by each of the given groupings in turn.
+// Running timers are skipped and counted in the second return value. Date
+// groups are ordered chronologically, all others by total time.
+func BuildTimeReport(entries []TimeEntry, by []string) (*ReportRow, int, error) {
+	for _, g := range by {
+		if !slices.Contains(ReportGroupings, g) {
+			return nil, 0, fmt.Errorf("invalid grouping %q (expected one of %s)", g, strings.Join(ReportGroupings, ", "))
+		}
+	}
+
+	root := &ReportRow{Key: "Total"}
+	running := 0
+	for _, e := range entries {
+		durationMs, _ := strconv.ParseInt(e.Duration, 10, 64)
+		if e.End == "" && durationMs < 0 {
+			running++
+			continue
+		}
+		root.add(durationMs, e.Billable)
+		addToReport(root, e, by, durationMs)
+	}
+	sortReport(root)
+
+	return root, running, nil
+}
+
+func addToReport(r *ReportRow, e TimeEntry, by []string, durationMs int64) {
+	if len(by) == 0 {
+		return
+	}
+	chronological := by[0] == "day" || by[0] == "week"
+	for _, key := range reportKeys(e, by[0]) {
+		c := r.child(key, chronological)
+		c.add(durationMs, e.Billable)
+		addToReport(c, e, by[1:], durationMs)
+	}
+}
+
+func sortReport(r *ReportRow) {
+	sort.SliceStable(r.Children, func(i, j int) bool {
+		a, b := r.Children[i], r.Children[j]
+		if a.chronological {
+			return a.Key < b.Key
+		}
+		if a.TotalMs != b.TotalMs {
+			return a.TotalMs > b.TotalMs
+		}
+		return a.Key < b.Key
+	})
+	for _, c := range r.Children {
+		sortReport(c)
+	}
+}
+
+// FormatTimeReport renders a report as an indented text table, one row per
+// group with its subgroups beneath it, followed by the grand total.
+func FormatTimeReport(root *ReportRow) string {
+	type line struct {
+		label string
+		row   *ReportRow
+	}
+	var lines []line
+	var walk func(r *ReportRow, depth int)
+	walk = func(r *ReportRow, depth int) {
+		for _, c := range r.Children {
+			lines = append(lines, line{strings.Repeat("  ", depth) + c.Key, c})
+			walk(c, depth+1)
+		}
+	}
+	walk(root, 0)
+
+	width := len("GROUP")
+	for _, l := range lines {
+		width = max(width, len([]rune(l.label)))
+	}
+	width = min(width, 60)
+
+	var b strings.Builder
+	row := func(label, entries, total, billable, nonBillable string) {
+		if r := []rune(label); len(r) > width {
+			label = string(r[:width-1]) + "…"
+		}
+		fmt.Fprintf(&b, "%-*s  %7s  %9s  %9s  %9s\n", width, label, entries, total, billable, nonBillable)
+	}
+	durations := func(r *ReportRow) (string, string, string, string) {
+		return strconv.Itoa(r.Entries), FormatDurationMs(r.TotalMs), FormatDurationMs(r.BillableMs), FormatDurationMs(r.NonBillableMs())
+	}
+
+	row("GROUP", "ENTRIES", "TOTAL", "BILLABLE", "NON-BILL")
+	for _, l := range lines {
+		e, t, bl, nb := durations(l.row)
+		row(l.label, e, t, bl, nb)
+	}
+	if len(lines) > 0 {
+		b.WriteString(strings.Repeat("-", width+42) + "\n")
+	}
+	e, t, bl, nb := durations(root)
+	row(root.Key, e, t, bl, nb)
+
+	return b.String()
+}
+
+// ReportLeaves returns the path of group keys to every leaf row, in report
+// order, for flat output such as CSV.
+func ReportLeaves(root *ReportRow) ([][]string, []*ReportRow) {
+	var paths [][]string
+	var rows []*ReportRow
+	var walk func(r *ReportRow, path []string)
+	walk = func(r *ReportRow, path []string) {
+		if len(r.Children) == 0 {
+			paths = append(paths, path)
+			rows = append(rows, r)
+			return
+		}
+		for _, c := range r.Children {
+			walk(c, append(path[:len(path):len(path)], c.Key))
+		}
+	}
+	walk(root, nil)
+	return paths, rows
+}
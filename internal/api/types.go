@@ -32,29 +32,79 @@ func (fi *FlexInt) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// FlexInt64 is the int64 counterpart of FlexInt, used for millisecond
+// timestamps that may arrive as either a number or a string.
+type FlexInt64 int64
+
+func (fi *FlexInt64) UnmarshalJSON(b []byte) error {
+	var n int64
+	if err := json.Unmarshal(b, &n); err == nil {
+		*fi = FlexInt64(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s == "" {
+			*fi = 0
+			return nil
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		*fi = FlexInt64(n)
+		return nil
+	}
+	return nil
+}
+
+// FlexString handles JSON values that may arrive as either a string or a
+// number, such as IDs and timestamps on some endpoints.
+type FlexString string
+
+func (fs *FlexString) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*fs = FlexString(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err == nil {
+		*fs = FlexString(n.String())
+		return nil
+	}
+	return nil
+}
+
 // Task represents a ClickUp task.
 type Task struct {
-	ID           string       `json:"id"`
-	CustomID     *string      `json:"custom_id"`
-	Name         string       `json:"name"`
-	Description  string       `json:"description"`
-	Status       Status       `json:"status"`
-	Priority     *Priority    `json:"priority"`
-	Assignees    []User       `json:"assignees"`
-	Watchers     []User       `json:"watchers"`
-	Creator      User         `json:"creator"`
-	List         ListRef      `json:"list"`
-	Space        SpaceRef     `json:"space"`
-	Tags         []Tag        `json:"tags"`
-	Parent       *string      `json:"parent"`
-	DueDate      *string      `json:"due_date"`
-	DateCreated  string       `json:"date_created"`
-	TimeEstimate *int64       `json:"time_estimate"`
-	TimeSpent    *int64       `json:"time_spent"`
-	URL          string       `json:"url"`
-	Subtasks     []Task       `json:"subtasks"`
-	Dependencies []Dependency `json:"dependencies"`
-	LinkedTasks  []LinkedTask `json:"linked_tasks"`
+	ID           string        `json:"id"`
+	CustomID     *string       `json:"custom_id"`
+	Name         string        `json:"name"`
+	Description  string        `json:"description"`
+	Status       Status        `json:"status"`
+	Priority     *Priority     `json:"priority"`
+	Assignees    []User        `json:"assignees"`
+	Watchers     []User        `json:"watchers"`
+	Creator      User          `json:"creator"`
+	List         ListRef       `json:"list"`
+	Space        SpaceRef      `json:"space"`
+	Tags         []Tag         `json:"tags"`
+	Parent       *string       `json:"parent"`
+	DueDate      *string       `json:"due_date"`
+	DateCreated  string        `json:"date_created"`
+	TimeEstimate *int64        `json:"time_estimate"`
+	TimeSpent    *int64        `json:"time_spent"`
+	URL          string        `json:"url"`
+	Subtasks     []Task        `json:"subtasks"`
+	Dependencies []Dependency  `json:"dependencies"`
+	LinkedTasks  []LinkedTask  `json:"linked_tasks"`
+	CustomFields []CustomField `json:"custom_fields"`
+	Checklists   []Checklist   `json:"checklists"`
+
+	// MarkdownDescription is only returned when requested with
+	// include_markdown_description=true.
+	MarkdownDescription string `json:"markdown_description"`
 }
 
 type Status struct {
@@ -69,10 +119,32 @@ type Priority struct {
 type User struct {
 	ID       int    `json:"id"`
 	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// Team represents a ClickUp workspace (called "team" in the v2 API).
+type Team struct {
+	ID      string       `json:"id"`
+	Name    string       `json:"name"`
+	Members []TeamMember `json:"members"`
+}
+
+type TeamMember struct {
+	User User `json:"user"`
+}
+
+type TeamsResponse struct {
+	Teams []Team `json:"teams"`
 }
 
 type Tag struct {
-	Name string `json:"name"`
+	Name  string `json:"name"`
+	TagFg string `json:"tag_fg"`
+	TagBg string `json:"tag_bg"`
+}
+
+type TagsResponse struct {
+	Tags []Tag `json:"tags"`
 }
 
 type ListRef struct {
@@ -93,16 +165,93 @@ type Dependency struct {
 	UserID      string `json:"userid"`
 }
 
+// Other returns the ID of the task on the other side of the dependency
+// from taskID, and whether taskID is waiting on it (as opposed to
+// blocking it).
+func (d Dependency) Other(taskID string) (other string, waitingOn bool) {
+	if d.TaskID == taskID {
+		return d.DependsOn, true
+	}
+	return d.TaskID, false
+}
+
 type LinkedTask struct {
+	TaskID      string `json:"task_id"`
 	LinkID      string `json:"link_id"`
 	DateCreated string `json:"date_created"`
 	UserID      string `json:"userid"`
 	WorkspaceID string `json:"workspace_id"`
 }
 
+// Other returns the ID of the task linked to taskID.
+func (l LinkedTask) Other(taskID string) string {
+	if l.TaskID == taskID {
+		return l.LinkID
+	}
+	return l.TaskID
+}
+
+// CustomField is a custom field definition. On tasks it also carries the
+// task's value, whose JSON shape depends on Type (see FormatFieldValue).
+type CustomField struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	TypeConfig FieldTypeConfig `json:"type_config"`
+	Required   bool            `json:"required"`
+	Value      json.RawMessage `json:"value"`
+}
+
+type FieldTypeConfig struct {
+	Options []FieldOption `json:"options"`
+}
+
+// FieldOption is a drop_down or labels option. Dropdown options use Name,
+// label options use Label.
+type FieldOption struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Label      string  `json:"label"`
+	Color      string  `json:"color"`
+	OrderIndex FlexInt `json:"orderindex"`
+}
+
+// DisplayName returns the option's name or label, whichever is set.
+func (o FieldOption) DisplayName() string {
+	return Or(o.Name, o.Label)
+}
+
+type FieldsResponse struct {
+	Fields []CustomField `json:"fields"`
+}
+
+// Checklist represents a checklist on a task.
+type Checklist struct {
+	ID         string          `json:"id"`
+	TaskID     string          `json:"task_id"`
+	Name       string          `json:"name"`
+	OrderIndex FlexInt         `json:"orderindex"`
+	Items      []ChecklistItem `json:"items"`
+}
+
+type ChecklistItem struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	OrderIndex FlexInt         `json:"orderindex"`
+	Assignee   *User           `json:"assignee"`
+	Resolved   bool            `json:"resolved"`
+	Parent     *string         `json:"parent"`
+	Children   []ChecklistItem `json:"children"`
+}
+
+type ChecklistResponse struct {
+	Checklist Checklist `json:"checklist"`
+}
+
 // TasksResponse wraps the tasks array from the API.
 type TasksResponse struct {
-	Tasks []Task `json:"tasks"`
+	Tasks    []Task `json:"tasks"`
+	LastPage bool   `json:"last_page"`
 }
 
 // Space represents a ClickUp space.
@@ -119,10 +268,13 @@ type SpacesResponse struct {
 
 // Folder represents a ClickUp folder.
 type Folder struct {
-	ID     string     `json:"id"`
-	Name   string     `json:"name"`
-	Hidden bool       `json:"hidden"`
-	Lists  []ListInfo `json:"lists"`
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	Hidden           bool       `json:"hidden"`
+	Lists            []ListInfo `json:"lists"`
+	TaskCount        FlexInt    `json:"task_count"`
+	OverrideStatuses bool       `json:"override_statuses"`
+	Space            SpaceRef   `json:"space"`
 }
 
 type FoldersResponse struct {
@@ -150,40 +302,152 @@ type ListsResponse struct {
 	Lists []ListInfo `json:"lists"`
 }
 
-// Comment represents a ClickUp task comment.
+// Comment represents a ClickUp comment. Comment holds the structured
+// content; CommentText is the API's plain-text flattening of it.
 type Comment struct {
-	ID          string `json:"id"`
-	CommentText string `json:"comment_text"`
-	User        User   `json:"user"`
-	Date        string `json:"date"`
+	ID          string      `json:"id"`
+	Comment     []CommentOp `json:"comment"`
+	CommentText string      `json:"comment_text"`
+	User        User        `json:"user"`
+	Date        string      `json:"date"`
+	Resolved    bool        `json:"resolved"`
+	Assignee    *User       `json:"assignee"`
+	AssignedBy  *User       `json:"assigned_by"`
+	Reactions   []Reaction  `json:"reactions"`
+	ReplyCount  FlexInt     `json:"reply_count"`
+
+	// Replies is not part of the API response; it is filled in by callers
+	// that fetch the comment's thread.
+	Replies []Comment `json:"-"`
+}
+
+// CommentOp is one segment of a comment's structured content: a run of
+// text, or a mention ("tag"), attachment, emoticon and so on.
+// See OpsToMarkdown for how ops map to Markdown.
+type CommentOp struct {
+	Type        string        `json:"type,omitempty"`
+	Text        string        `json:"text"`
+	Attributes  *OpAttributes `json:"attributes,omitempty"`
+	User        *User         `json:"user,omitempty"`
+	Attachment  *Attachment   `json:"attachment,omitempty"`
+	TaskMention *TaskMention  `json:"task_mention,omitempty"`
+	Bookmark    *Bookmark     `json:"bookmark,omitempty"`
+}
+
+type TaskMention struct {
+	TaskID string `json:"task_id"`
+}
+
+type Bookmark struct {
+	URL string `json:"url"`
+}
+
+type Attachment struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+type Reaction struct {
+	Reaction string     `json:"reaction"`
+	Date     FlexString `json:"date"`
+	User     User       `json:"user"`
 }
 
 type CommentsResponse struct {
 	Comments []Comment `json:"comments"`
 }
 
-// TimeEntry represents a ClickUp time entry.
+// CreatedComment is the response to creating a comment.
+type CreatedComment struct {
+	ID     FlexString `json:"id"`
+	HistID string     `json:"hist_id"`
+	Date   FlexString `json:"date"`
+}
+
+// TimeEntry represents a ClickUp time entry. While a timer is running,
+// End is empty and Duration is negative.
 type TimeEntry struct {
-	ID          string `json:"id"`
-	User        User   `json:"user"`
-	Duration    string `json:"duration"`
-	Start       string `json:"start"`
-	Description string `json:"description"`
+	ID          string         `json:"id"`
+	Task        *TimeEntryTask `json:"task"`
+	User        User           `json:"user"`
+	Billable    bool           `json:"billable"`
+	Duration    string         `json:"duration"`
+	Start       string         `json:"start"`
+	End         string         `json:"end"`
+	Description string         `json:"description"`
+	Tags        []Tag          `json:"tags"`
+	TaskURL     string         `json:"task_url"`
+
+	// Only populated when requested with include_location_names and
+	// include_task_tags.
+	TaskLocation *TaskLocation `json:"task_location"`
+	TaskTags     []Tag         `json:"task_tags"`
+}
+
+// TaskLocation is the list, folder and space a time entry's task lives in.
+type TaskLocation struct {
+	ListID     string `json:"list_id"`
+	FolderID   string `json:"folder_id"`
+	SpaceID    string `json:"space_id"`
+	ListName   string `json:"list_name"`
+	FolderName string `json:"folder_name"`
+	SpaceName  string `json:"space_name"`
+}
+
+// TimeEntryTask is the task a time entry is tracked against.
+type TimeEntryTask struct {
+	ID       string  `json:"id"`
+	CustomID *string `json:"custom_id"`
+	Name     string  `json:"name"`
+	Status   Status  `json:"status"`
 }
 
 type TimeEntriesResponse struct {
 	Data []TimeEntry `json:"data"`
 }
 
-// Document represents a ClickUp document.
+type TimeEntryResponse struct {
+	Data *TimeEntry `json:"data"`
+}
+
+// Document represents a ClickUp document as returned by the v3 API. Its
+// content lives in its pages.
 type Document struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Content     string `json:"content"`
-	DateCreated string `json:"date_created"`
-	Creator     User   `json:"creator"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	DateCreated FlexString `json:"date_created"`
+	DateUpdated FlexString `json:"date_updated"`
+	Creator     FlexInt64  `json:"creator"`
+	Parent      DocParent  `json:"parent"`
+	Deleted     bool       `json:"deleted"`
+	Archived    bool       `json:"archived"`
+}
+
+// DocParent is the location a document belongs to. Type is a numeric
+// location type, e.g. 4 for a space, 5 for a folder and 6 for a list.
+type DocParent struct {
+	ID   string  `json:"id"`
+	Type FlexInt `json:"type"`
 }
 
 type DocsResponse struct {
-	Docs []Document `json:"docs"`
+	Docs       []Document `json:"docs"`
+	NextCursor string     `json:"next_cursor"`
+}
+
+// DocPage is a page of a document. Pages nest; Content is only returned
+// when pages are fetched rather than listed.
+type DocPage struct {
+	ID           string     `json:"id"`
+	DocID        string     `json:"doc_id"`
+	ParentPageID string     `json:"parent_page_id"`
+	Name         string     `json:"name"`
+	SubTitle     string     `json:"sub_title"`
+	Content      string     `json:"content"`
+	DateCreated  FlexString `json:"date_created"`
+	DateUpdated  FlexString `json:"date_updated"`
+	CreatorID    FlexInt64  `json:"creator_id"`
+	Archived     bool       `json:"archived"`
+	Pages        []DocPage  `json:"pages"`
 }